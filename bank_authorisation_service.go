@@ -24,21 +24,24 @@ type BankAuthorisationServiceImpl struct {
 }
 
 type BankAuthorisationLinks struct {
-	BillingRequest string `url:"billing_request,omitempty" json:"billing_request,omitempty"`
-	Institution    string `url:"institution,omitempty" json:"institution,omitempty"`
+	BillingRequest string                     `url:"billing_request,omitempty" json:"billing_request,omitempty"`
+	Institution    string                     `url:"institution,omitempty" json:"institution,omitempty"`
+	Extra          map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 // BankAuthorisation model
 type BankAuthorisation struct {
-	AuthorisationType string                  `url:"authorisation_type,omitempty" json:"authorisation_type,omitempty"`
-	AuthorisedAt      string                  `url:"authorised_at,omitempty" json:"authorised_at,omitempty"`
-	CreatedAt         string                  `url:"created_at,omitempty" json:"created_at,omitempty"`
-	ExpiresAt         string                  `url:"expires_at,omitempty" json:"expires_at,omitempty"`
-	Id                string                  `url:"id,omitempty" json:"id,omitempty"`
-	LastVisitedAt     string                  `url:"last_visited_at,omitempty" json:"last_visited_at,omitempty"`
-	Links             *BankAuthorisationLinks `url:"links,omitempty" json:"links,omitempty"`
-	RedirectUri       string                  `url:"redirect_uri,omitempty" json:"redirect_uri,omitempty"`
-	Url               string                  `url:"url,omitempty" json:"url,omitempty"`
+	AuthorisationType string                     `url:"authorisation_type,omitempty" json:"authorisation_type,omitempty"`
+	AuthorisedAt      *Timestamp                 `url:"authorised_at,omitempty" json:"authorised_at,omitempty"`
+	CreatedAt         *Timestamp                 `url:"created_at,omitempty" json:"created_at,omitempty"`
+	ExpiresAt         *Timestamp                 `url:"expires_at,omitempty" json:"expires_at,omitempty"`
+	Id                string                     `url:"id,omitempty" json:"id,omitempty"`
+	LastVisitedAt     *Timestamp                 `url:"last_visited_at,omitempty" json:"last_visited_at,omitempty"`
+	Links             *BankAuthorisationLinks    `url:"links,omitempty" json:"links,omitempty"`
+	RedirectUri       string                     `url:"redirect_uri,omitempty" json:"redirect_uri,omitempty"`
+	Url               string                     `url:"url,omitempty" json:"url,omitempty"`
+	Extra             map[string]json.RawMessage `url:"-" json:"-"`
+	raw               json.RawMessage
 }
 
 type BankAuthorisationService interface {
@@ -141,6 +144,10 @@ type BankAuthorisationCreateParams struct {
 // Create
 // Create a Bank Authorisation.
 func (s *BankAuthorisationServiceImpl) Create(ctx context.Context, p BankAuthorisationCreateParams, opts ...RequestOption) (*BankAuthorisation, error) {
+	if err := validateParams("bank_authorisations", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/bank_authorisations"))
 	if err != nil {
 		return nil, err
@@ -25,9 +25,11 @@ type BankDetailsLookupServiceImpl struct {
 
 // BankDetailsLookup model
 type BankDetailsLookup struct {
-	AvailableDebitSchemes []string `url:"available_debit_schemes,omitempty" json:"available_debit_schemes,omitempty"`
-	BankName              string   `url:"bank_name,omitempty" json:"bank_name,omitempty"`
-	Bic                   string   `url:"bic,omitempty" json:"bic,omitempty"`
+	AvailableDebitSchemes []string                   `url:"available_debit_schemes,omitempty" json:"available_debit_schemes,omitempty"`
+	BankName              string                     `url:"bank_name,omitempty" json:"bank_name,omitempty"`
+	Bic                   string                     `url:"bic,omitempty" json:"bic,omitempty"`
+	Extra                 map[string]json.RawMessage `url:"-" json:"-"`
+	raw                   json.RawMessage
 }
 
 type BankDetailsLookupService interface {
@@ -69,6 +71,10 @@ type BankDetailsLookupCreateParams struct {
 // modulus or reachability checking but not for payment collection, please get
 // in touch.
 func (s *BankDetailsLookupServiceImpl) Create(ctx context.Context, p BankDetailsLookupCreateParams, opts ...RequestOption) (*BankDetailsLookup, error) {
+	if err := validateParams("bank_details_lookups", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/bank_details_lookups"))
 	if err != nil {
 		return nil, err
@@ -24,36 +24,39 @@ type BillingRequestFlowServiceImpl struct {
 }
 
 type BillingRequestFlowLinks struct {
-	BillingRequest string `url:"billing_request,omitempty" json:"billing_request,omitempty"`
+	BillingRequest string                     `url:"billing_request,omitempty" json:"billing_request,omitempty"`
+	Extra          map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type BillingRequestFlowPrefilledBankAccount struct {
-	AccountType string `url:"account_type,omitempty" json:"account_type,omitempty"`
+	AccountType string                     `url:"account_type,omitempty" json:"account_type,omitempty"`
+	Extra       map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type BillingRequestFlowPrefilledCustomer struct {
-	AddressLine1          string `url:"address_line1,omitempty" json:"address_line1,omitempty"`
-	AddressLine2          string `url:"address_line2,omitempty" json:"address_line2,omitempty"`
-	AddressLine3          string `url:"address_line3,omitempty" json:"address_line3,omitempty"`
-	City                  string `url:"city,omitempty" json:"city,omitempty"`
-	CompanyName           string `url:"company_name,omitempty" json:"company_name,omitempty"`
-	CountryCode           string `url:"country_code,omitempty" json:"country_code,omitempty"`
-	DanishIdentityNumber  string `url:"danish_identity_number,omitempty" json:"danish_identity_number,omitempty"`
-	Email                 string `url:"email,omitempty" json:"email,omitempty"`
-	FamilyName            string `url:"family_name,omitempty" json:"family_name,omitempty"`
-	GivenName             string `url:"given_name,omitempty" json:"given_name,omitempty"`
-	PostalCode            string `url:"postal_code,omitempty" json:"postal_code,omitempty"`
-	Region                string `url:"region,omitempty" json:"region,omitempty"`
-	SwedishIdentityNumber string `url:"swedish_identity_number,omitempty" json:"swedish_identity_number,omitempty"`
+	AddressLine1          string                     `url:"address_line1,omitempty" json:"address_line1,omitempty"`
+	AddressLine2          string                     `url:"address_line2,omitempty" json:"address_line2,omitempty"`
+	AddressLine3          string                     `url:"address_line3,omitempty" json:"address_line3,omitempty"`
+	City                  string                     `url:"city,omitempty" json:"city,omitempty"`
+	CompanyName           string                     `url:"company_name,omitempty" json:"company_name,omitempty"`
+	CountryCode           string                     `url:"country_code,omitempty" json:"country_code,omitempty"`
+	DanishIdentityNumber  string                     `url:"danish_identity_number,omitempty" json:"danish_identity_number,omitempty"`
+	Email                 string                     `url:"email,omitempty" json:"email,omitempty"`
+	FamilyName            string                     `url:"family_name,omitempty" json:"family_name,omitempty"`
+	GivenName             string                     `url:"given_name,omitempty" json:"given_name,omitempty"`
+	PostalCode            string                     `url:"postal_code,omitempty" json:"postal_code,omitempty"`
+	Region                string                     `url:"region,omitempty" json:"region,omitempty"`
+	SwedishIdentityNumber string                     `url:"swedish_identity_number,omitempty" json:"swedish_identity_number,omitempty"`
+	Extra                 map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 // BillingRequestFlow model
 type BillingRequestFlow struct {
 	AuthorisationUrl     string                                  `url:"authorisation_url,omitempty" json:"authorisation_url,omitempty"`
 	AutoFulfil           bool                                    `url:"auto_fulfil,omitempty" json:"auto_fulfil,omitempty"`
-	CreatedAt            string                                  `url:"created_at,omitempty" json:"created_at,omitempty"`
+	CreatedAt            *Timestamp                              `url:"created_at,omitempty" json:"created_at,omitempty"`
 	ExitUri              string                                  `url:"exit_uri,omitempty" json:"exit_uri,omitempty"`
-	ExpiresAt            string                                  `url:"expires_at,omitempty" json:"expires_at,omitempty"`
+	ExpiresAt            *Timestamp                              `url:"expires_at,omitempty" json:"expires_at,omitempty"`
 	Id                   string                                  `url:"id,omitempty" json:"id,omitempty"`
 	Language             string                                  `url:"language,omitempty" json:"language,omitempty"`
 	Links                *BillingRequestFlowLinks                `url:"links,omitempty" json:"links,omitempty"`
@@ -65,6 +68,8 @@ type BillingRequestFlow struct {
 	RedirectUri          string                                  `url:"redirect_uri,omitempty" json:"redirect_uri,omitempty"`
 	SessionToken         string                                  `url:"session_token,omitempty" json:"session_token,omitempty"`
 	ShowRedirectButtons  bool                                    `url:"show_redirect_buttons,omitempty" json:"show_redirect_buttons,omitempty"`
+	Extra                map[string]json.RawMessage              `url:"-" json:"-"`
+	raw                  json.RawMessage
 }
 
 type BillingRequestFlowService interface {
@@ -114,6 +119,10 @@ type BillingRequestFlowCreateParams struct {
 // Create
 // Creates a new billing request flow.
 func (s *BillingRequestFlowServiceImpl) Create(ctx context.Context, p BillingRequestFlowCreateParams, opts ...RequestOption) (*BillingRequestFlow, error) {
+	if err := validateParams("billing_request_flows", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/billing_request_flows"))
 	if err != nil {
 		return nil, err
@@ -24,17 +24,20 @@ type BillingRequestServiceImpl struct {
 }
 
 type BillingRequestActionsAvailableCurrencies struct {
-	Currency string `url:"currency,omitempty" json:"currency,omitempty"`
+	Currency string                     `url:"currency,omitempty" json:"currency,omitempty"`
+	Extra    map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type BillingRequestActionsBankAuthorisation struct {
-	Adapter             string `url:"adapter,omitempty" json:"adapter,omitempty"`
-	AuthorisationType   string `url:"authorisation_type,omitempty" json:"authorisation_type,omitempty"`
-	RequiresInstitution bool   `url:"requires_institution,omitempty" json:"requires_institution,omitempty"`
+	Adapter             string                     `url:"adapter,omitempty" json:"adapter,omitempty"`
+	AuthorisationType   string                     `url:"authorisation_type,omitempty" json:"authorisation_type,omitempty"`
+	RequiresInstitution bool                       `url:"requires_institution,omitempty" json:"requires_institution,omitempty"`
+	Extra               map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type BillingRequestActionsCollectCustomerDetails struct {
-	DefaultCountryCode string `url:"default_country_code,omitempty" json:"default_country_code,omitempty"`
+	DefaultCountryCode string                     `url:"default_country_code,omitempty" json:"default_country_code,omitempty"`
+	Extra              map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type BillingRequestActions struct {
@@ -46,37 +49,42 @@ type BillingRequestActions struct {
 	RequiresActions        []string                                     `url:"requires_actions,omitempty" json:"requires_actions,omitempty"`
 	Status                 string                                       `url:"status,omitempty" json:"status,omitempty"`
 	Type                   string                                       `url:"type,omitempty" json:"type,omitempty"`
+	Extra                  map[string]json.RawMessage                   `url:"-" json:"-"`
 }
 
 type BillingRequestLinks struct {
-	BankAuthorisation     string `url:"bank_authorisation,omitempty" json:"bank_authorisation,omitempty"`
-	Creditor              string `url:"creditor,omitempty" json:"creditor,omitempty"`
-	Customer              string `url:"customer,omitempty" json:"customer,omitempty"`
-	CustomerBankAccount   string `url:"customer_bank_account,omitempty" json:"customer_bank_account,omitempty"`
-	CustomerBillingDetail string `url:"customer_billing_detail,omitempty" json:"customer_billing_detail,omitempty"`
-	MandateRequest        string `url:"mandate_request,omitempty" json:"mandate_request,omitempty"`
-	MandateRequestMandate string `url:"mandate_request_mandate,omitempty" json:"mandate_request_mandate,omitempty"`
-	Organisation          string `url:"organisation,omitempty" json:"organisation,omitempty"`
-	PaymentRequest        string `url:"payment_request,omitempty" json:"payment_request,omitempty"`
-	PaymentRequestPayment string `url:"payment_request_payment,omitempty" json:"payment_request_payment,omitempty"`
+	BankAuthorisation     string                     `url:"bank_authorisation,omitempty" json:"bank_authorisation,omitempty"`
+	Creditor              string                     `url:"creditor,omitempty" json:"creditor,omitempty"`
+	Customer              string                     `url:"customer,omitempty" json:"customer,omitempty"`
+	CustomerBankAccount   string                     `url:"customer_bank_account,omitempty" json:"customer_bank_account,omitempty"`
+	CustomerBillingDetail string                     `url:"customer_billing_detail,omitempty" json:"customer_billing_detail,omitempty"`
+	MandateRequest        string                     `url:"mandate_request,omitempty" json:"mandate_request,omitempty"`
+	MandateRequestMandate string                     `url:"mandate_request_mandate,omitempty" json:"mandate_request_mandate,omitempty"`
+	Organisation          string                     `url:"organisation,omitempty" json:"organisation,omitempty"`
+	PaymentRequest        string                     `url:"payment_request,omitempty" json:"payment_request,omitempty"`
+	PaymentRequestPayment string                     `url:"payment_request_payment,omitempty" json:"payment_request_payment,omitempty"`
+	Extra                 map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type BillingRequestMandateRequestConstraintsPeriodicLimits struct {
-	Alignment      string `url:"alignment,omitempty" json:"alignment,omitempty"`
-	MaxPayments    int    `url:"max_payments,omitempty" json:"max_payments,omitempty"`
-	MaxTotalAmount int    `url:"max_total_amount,omitempty" json:"max_total_amount,omitempty"`
-	Period         string `url:"period,omitempty" json:"period,omitempty"`
+	Alignment      string                     `url:"alignment,omitempty" json:"alignment,omitempty"`
+	MaxPayments    int                        `url:"max_payments,omitempty" json:"max_payments,omitempty"`
+	MaxTotalAmount int                        `url:"max_total_amount,omitempty" json:"max_total_amount,omitempty"`
+	Period         string                     `url:"period,omitempty" json:"period,omitempty"`
+	Extra          map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type BillingRequestMandateRequestConstraints struct {
-	EndDate             string                                                  `url:"end_date,omitempty" json:"end_date,omitempty"`
+	EndDate             *Date                                                   `url:"end_date,omitempty" json:"end_date,omitempty"`
 	MaxAmountPerPayment int                                                     `url:"max_amount_per_payment,omitempty" json:"max_amount_per_payment,omitempty"`
 	PeriodicLimits      []BillingRequestMandateRequestConstraintsPeriodicLimits `url:"periodic_limits,omitempty" json:"periodic_limits,omitempty"`
-	StartDate           string                                                  `url:"start_date,omitempty" json:"start_date,omitempty"`
+	StartDate           *Date                                                   `url:"start_date,omitempty" json:"start_date,omitempty"`
+	Extra               map[string]json.RawMessage                              `url:"-" json:"-"`
 }
 
 type BillingRequestMandateRequestLinks struct {
-	Mandate string `url:"mandate,omitempty" json:"mandate,omitempty"`
+	Mandate string                     `url:"mandate,omitempty" json:"mandate,omitempty"`
+	Extra   map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type BillingRequestMandateRequest struct {
@@ -84,13 +92,15 @@ type BillingRequestMandateRequest struct {
 	Currency    string                                   `url:"currency,omitempty" json:"currency,omitempty"`
 	Description string                                   `url:"description,omitempty" json:"description,omitempty"`
 	Links       *BillingRequestMandateRequestLinks       `url:"links,omitempty" json:"links,omitempty"`
-	Metadata    map[string]interface{}                   `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata    Metadata                                 `url:"metadata,omitempty" json:"metadata,omitempty"`
 	Scheme      string                                   `url:"scheme,omitempty" json:"scheme,omitempty"`
 	Verify      string                                   `url:"verify,omitempty" json:"verify,omitempty"`
+	Extra       map[string]json.RawMessage               `url:"-" json:"-"`
 }
 
 type BillingRequestPaymentRequestLinks struct {
-	Payment string `url:"payment,omitempty" json:"payment,omitempty"`
+	Payment string                     `url:"payment,omitempty" json:"payment,omitempty"`
+	Extra   map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type BillingRequestPaymentRequest struct {
@@ -99,24 +109,27 @@ type BillingRequestPaymentRequest struct {
 	Currency    string                             `url:"currency,omitempty" json:"currency,omitempty"`
 	Description string                             `url:"description,omitempty" json:"description,omitempty"`
 	Links       *BillingRequestPaymentRequestLinks `url:"links,omitempty" json:"links,omitempty"`
-	Metadata    map[string]interface{}             `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata    Metadata                           `url:"metadata,omitempty" json:"metadata,omitempty"`
 	Scheme      string                             `url:"scheme,omitempty" json:"scheme,omitempty"`
+	Extra       map[string]json.RawMessage         `url:"-" json:"-"`
 }
 
 type BillingRequestResourcesCustomer struct {
-	CompanyName string                 `url:"company_name,omitempty" json:"company_name,omitempty"`
-	CreatedAt   string                 `url:"created_at,omitempty" json:"created_at,omitempty"`
-	Email       string                 `url:"email,omitempty" json:"email,omitempty"`
-	FamilyName  string                 `url:"family_name,omitempty" json:"family_name,omitempty"`
-	GivenName   string                 `url:"given_name,omitempty" json:"given_name,omitempty"`
-	Id          string                 `url:"id,omitempty" json:"id,omitempty"`
-	Language    string                 `url:"language,omitempty" json:"language,omitempty"`
-	Metadata    map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	PhoneNumber string                 `url:"phone_number,omitempty" json:"phone_number,omitempty"`
+	CompanyName string                     `url:"company_name,omitempty" json:"company_name,omitempty"`
+	CreatedAt   *Timestamp                 `url:"created_at,omitempty" json:"created_at,omitempty"`
+	Email       string                     `url:"email,omitempty" json:"email,omitempty"`
+	FamilyName  string                     `url:"family_name,omitempty" json:"family_name,omitempty"`
+	GivenName   string                     `url:"given_name,omitempty" json:"given_name,omitempty"`
+	Id          string                     `url:"id,omitempty" json:"id,omitempty"`
+	Language    string                     `url:"language,omitempty" json:"language,omitempty"`
+	Metadata    Metadata                   `url:"metadata,omitempty" json:"metadata,omitempty"`
+	PhoneNumber string                     `url:"phone_number,omitempty" json:"phone_number,omitempty"`
+	Extra       map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type BillingRequestResourcesCustomerBankAccountLinks struct {
-	Customer string `url:"customer,omitempty" json:"customer,omitempty"`
+	Customer string                     `url:"customer,omitempty" json:"customer,omitempty"`
+	Extra    map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type BillingRequestResourcesCustomerBankAccount struct {
@@ -125,48 +138,53 @@ type BillingRequestResourcesCustomerBankAccount struct {
 	AccountType         string                                           `url:"account_type,omitempty" json:"account_type,omitempty"`
 	BankName            string                                           `url:"bank_name,omitempty" json:"bank_name,omitempty"`
 	CountryCode         string                                           `url:"country_code,omitempty" json:"country_code,omitempty"`
-	CreatedAt           string                                           `url:"created_at,omitempty" json:"created_at,omitempty"`
+	CreatedAt           *Timestamp                                       `url:"created_at,omitempty" json:"created_at,omitempty"`
 	Currency            string                                           `url:"currency,omitempty" json:"currency,omitempty"`
 	Enabled             bool                                             `url:"enabled,omitempty" json:"enabled,omitempty"`
 	Id                  string                                           `url:"id,omitempty" json:"id,omitempty"`
 	Links               *BillingRequestResourcesCustomerBankAccountLinks `url:"links,omitempty" json:"links,omitempty"`
-	Metadata            map[string]interface{}                           `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata            Metadata                                         `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Extra               map[string]json.RawMessage                       `url:"-" json:"-"`
 }
 
 type BillingRequestResourcesCustomerBillingDetail struct {
-	AddressLine1          string   `url:"address_line1,omitempty" json:"address_line1,omitempty"`
-	AddressLine2          string   `url:"address_line2,omitempty" json:"address_line2,omitempty"`
-	AddressLine3          string   `url:"address_line3,omitempty" json:"address_line3,omitempty"`
-	City                  string   `url:"city,omitempty" json:"city,omitempty"`
-	CountryCode           string   `url:"country_code,omitempty" json:"country_code,omitempty"`
-	CreatedAt             string   `url:"created_at,omitempty" json:"created_at,omitempty"`
-	DanishIdentityNumber  string   `url:"danish_identity_number,omitempty" json:"danish_identity_number,omitempty"`
-	Id                    string   `url:"id,omitempty" json:"id,omitempty"`
-	IpAddress             string   `url:"ip_address,omitempty" json:"ip_address,omitempty"`
-	PostalCode            string   `url:"postal_code,omitempty" json:"postal_code,omitempty"`
-	Region                string   `url:"region,omitempty" json:"region,omitempty"`
-	Schemes               []string `url:"schemes,omitempty" json:"schemes,omitempty"`
-	SwedishIdentityNumber string   `url:"swedish_identity_number,omitempty" json:"swedish_identity_number,omitempty"`
+	AddressLine1          string                     `url:"address_line1,omitempty" json:"address_line1,omitempty"`
+	AddressLine2          string                     `url:"address_line2,omitempty" json:"address_line2,omitempty"`
+	AddressLine3          string                     `url:"address_line3,omitempty" json:"address_line3,omitempty"`
+	City                  string                     `url:"city,omitempty" json:"city,omitempty"`
+	CountryCode           string                     `url:"country_code,omitempty" json:"country_code,omitempty"`
+	CreatedAt             *Timestamp                 `url:"created_at,omitempty" json:"created_at,omitempty"`
+	DanishIdentityNumber  string                     `url:"danish_identity_number,omitempty" json:"danish_identity_number,omitempty"`
+	Id                    string                     `url:"id,omitempty" json:"id,omitempty"`
+	IpAddress             string                     `url:"ip_address,omitempty" json:"ip_address,omitempty"`
+	PostalCode            string                     `url:"postal_code,omitempty" json:"postal_code,omitempty"`
+	Region                string                     `url:"region,omitempty" json:"region,omitempty"`
+	Schemes               []string                   `url:"schemes,omitempty" json:"schemes,omitempty"`
+	SwedishIdentityNumber string                     `url:"swedish_identity_number,omitempty" json:"swedish_identity_number,omitempty"`
+	Extra                 map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type BillingRequestResources struct {
 	Customer              *BillingRequestResourcesCustomer              `url:"customer,omitempty" json:"customer,omitempty"`
 	CustomerBankAccount   *BillingRequestResourcesCustomerBankAccount   `url:"customer_bank_account,omitempty" json:"customer_bank_account,omitempty"`
 	CustomerBillingDetail *BillingRequestResourcesCustomerBillingDetail `url:"customer_billing_detail,omitempty" json:"customer_billing_detail,omitempty"`
+	Extra                 map[string]json.RawMessage                    `url:"-" json:"-"`
 }
 
 // BillingRequest model
 type BillingRequest struct {
 	Actions         []BillingRequestActions       `url:"actions,omitempty" json:"actions,omitempty"`
-	CreatedAt       string                        `url:"created_at,omitempty" json:"created_at,omitempty"`
+	CreatedAt       *Timestamp                    `url:"created_at,omitempty" json:"created_at,omitempty"`
 	FallbackEnabled bool                          `url:"fallback_enabled,omitempty" json:"fallback_enabled,omitempty"`
 	Id              string                        `url:"id,omitempty" json:"id,omitempty"`
 	Links           *BillingRequestLinks          `url:"links,omitempty" json:"links,omitempty"`
 	MandateRequest  *BillingRequestMandateRequest `url:"mandate_request,omitempty" json:"mandate_request,omitempty"`
-	Metadata        map[string]interface{}        `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata        Metadata                      `url:"metadata,omitempty" json:"metadata,omitempty"`
 	PaymentRequest  *BillingRequestPaymentRequest `url:"payment_request,omitempty" json:"payment_request,omitempty"`
 	Resources       *BillingRequestResources      `url:"resources,omitempty" json:"resources,omitempty"`
-	Status          string                        `url:"status,omitempty" json:"status,omitempty"`
+	Status          BillingRequestStatus          `url:"status,omitempty" json:"status,omitempty"`
+	Extra           map[string]json.RawMessage    `url:"-" json:"-"`
+	raw             json.RawMessage
 }
 
 type BillingRequestService interface {
@@ -186,12 +204,12 @@ type BillingRequestService interface {
 
 // BillingRequestListParams parameters
 type BillingRequestListParams struct {
-	After     string `url:"after,omitempty" json:"after,omitempty"`
-	Before    string `url:"before,omitempty" json:"before,omitempty"`
-	CreatedAt string `url:"created_at,omitempty" json:"created_at,omitempty"`
-	Customer  string `url:"customer,omitempty" json:"customer,omitempty"`
-	Limit     int    `url:"limit,omitempty" json:"limit,omitempty"`
-	Status    string `url:"status,omitempty" json:"status,omitempty"`
+	After     string               `url:"after,omitempty" json:"after,omitempty"`
+	Before    string               `url:"before,omitempty" json:"before,omitempty"`
+	CreatedAt *Timestamp           `url:"created_at,omitempty" json:"created_at,omitempty"`
+	Customer  string               `url:"customer,omitempty" json:"customer,omitempty"`
+	Limit     int                  `url:"limit,omitempty" json:"limit,omitempty"`
+	Status    BillingRequestStatus `url:"status,omitempty" json:"status,omitempty"`
 }
 
 type BillingRequestListResultMetaCursors struct {
@@ -430,29 +448,29 @@ type BillingRequestCreateParamsMandateRequestConstraintsPeriodicLimits struct {
 }
 
 type BillingRequestCreateParamsMandateRequestConstraints struct {
-	EndDate             string                                                              `url:"end_date,omitempty" json:"end_date,omitempty"`
+	EndDate             *Date                                                               `url:"end_date,omitempty" json:"end_date,omitempty"`
 	MaxAmountPerPayment int                                                                 `url:"max_amount_per_payment,omitempty" json:"max_amount_per_payment,omitempty"`
 	PeriodicLimits      []BillingRequestCreateParamsMandateRequestConstraintsPeriodicLimits `url:"periodic_limits,omitempty" json:"periodic_limits,omitempty"`
-	StartDate           string                                                              `url:"start_date,omitempty" json:"start_date,omitempty"`
+	StartDate           *Date                                                               `url:"start_date,omitempty" json:"start_date,omitempty"`
 }
 
 type BillingRequestCreateParamsMandateRequest struct {
 	Constraints *BillingRequestCreateParamsMandateRequestConstraints `url:"constraints,omitempty" json:"constraints,omitempty"`
 	Currency    string                                               `url:"currency,omitempty" json:"currency,omitempty"`
 	Description string                                               `url:"description,omitempty" json:"description,omitempty"`
-	Metadata    map[string]interface{}                               `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata    Metadata                                             `url:"metadata,omitempty" json:"metadata,omitempty"`
 	Reference   string                                               `url:"reference,omitempty" json:"reference,omitempty"`
 	Scheme      string                                               `url:"scheme,omitempty" json:"scheme,omitempty"`
 	Verify      string                                               `url:"verify,omitempty" json:"verify,omitempty"`
 }
 
 type BillingRequestCreateParamsPaymentRequest struct {
-	Amount      int                    `url:"amount,omitempty" json:"amount,omitempty"`
-	AppFee      int                    `url:"app_fee,omitempty" json:"app_fee,omitempty"`
-	Currency    string                 `url:"currency,omitempty" json:"currency,omitempty"`
-	Description string                 `url:"description,omitempty" json:"description,omitempty"`
-	Metadata    map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	Scheme      string                 `url:"scheme,omitempty" json:"scheme,omitempty"`
+	Amount      int      `url:"amount,omitempty" json:"amount,omitempty"`
+	AppFee      int      `url:"app_fee,omitempty" json:"app_fee,omitempty"`
+	Currency    string   `url:"currency,omitempty" json:"currency,omitempty"`
+	Description string   `url:"description,omitempty" json:"description,omitempty"`
+	Metadata    Metadata `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Scheme      string   `url:"scheme,omitempty" json:"scheme,omitempty"`
 }
 
 // BillingRequestCreateParams parameters
@@ -460,13 +478,17 @@ type BillingRequestCreateParams struct {
 	FallbackEnabled bool                                      `url:"fallback_enabled,omitempty" json:"fallback_enabled,omitempty"`
 	Links           *BillingRequestCreateParamsLinks          `url:"links,omitempty" json:"links,omitempty"`
 	MandateRequest  *BillingRequestCreateParamsMandateRequest `url:"mandate_request,omitempty" json:"mandate_request,omitempty"`
-	Metadata        map[string]interface{}                    `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata        Metadata                                  `url:"metadata,omitempty" json:"metadata,omitempty"`
 	PaymentRequest  *BillingRequestCreateParamsPaymentRequest `url:"payment_request,omitempty" json:"payment_request,omitempty"`
 }
 
 // Create
 //
 func (s *BillingRequestServiceImpl) Create(ctx context.Context, p BillingRequestCreateParams, opts ...RequestOption) (*BillingRequest, error) {
+	if err := validateParams("billing_requests", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/billing_requests"))
 	if err != nil {
 		return nil, err
@@ -638,13 +660,13 @@ func (s *BillingRequestServiceImpl) Get(ctx context.Context, identity string, op
 }
 
 type BillingRequestCollectCustomerDetailsParamsCustomer struct {
-	CompanyName string                 `url:"company_name,omitempty" json:"company_name,omitempty"`
-	Email       string                 `url:"email,omitempty" json:"email,omitempty"`
-	FamilyName  string                 `url:"family_name,omitempty" json:"family_name,omitempty"`
-	GivenName   string                 `url:"given_name,omitempty" json:"given_name,omitempty"`
-	Language    string                 `url:"language,omitempty" json:"language,omitempty"`
-	Metadata    map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	PhoneNumber string                 `url:"phone_number,omitempty" json:"phone_number,omitempty"`
+	CompanyName string            `url:"company_name,omitempty" json:"company_name,omitempty"`
+	Email       string            `url:"email,omitempty" json:"email,omitempty"`
+	FamilyName  string            `url:"family_name,omitempty" json:"family_name,omitempty"`
+	GivenName   string            `url:"given_name,omitempty" json:"given_name,omitempty"`
+	Language    string            `url:"language,omitempty" json:"language,omitempty"`
+	Metadata    *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
+	PhoneNumber string            `url:"phone_number,omitempty" json:"phone_number,omitempty"`
 }
 
 type BillingRequestCollectCustomerDetailsParamsCustomerBillingDetail struct {
@@ -678,6 +700,10 @@ type BillingRequestCollectCustomerDetailsParams struct {
 // customer, and will take effect immediately after the request is
 // successful.
 func (s *BillingRequestServiceImpl) CollectCustomerDetails(ctx context.Context, identity string, p BillingRequestCollectCustomerDetailsParams, opts ...RequestOption) (*BillingRequest, error) {
+	if err := validateParams("data", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/billing_requests/%v/actions/collect_customer_details",
 		identity))
 	if err != nil {
@@ -771,16 +797,16 @@ func (s *BillingRequestServiceImpl) CollectCustomerDetails(ctx context.Context,
 
 // BillingRequestCollectBankAccountParams parameters
 type BillingRequestCollectBankAccountParams struct {
-	AccountHolderName   string                 `url:"account_holder_name,omitempty" json:"account_holder_name,omitempty"`
-	AccountNumber       string                 `url:"account_number,omitempty" json:"account_number,omitempty"`
-	AccountNumberSuffix string                 `url:"account_number_suffix,omitempty" json:"account_number_suffix,omitempty"`
-	AccountType         string                 `url:"account_type,omitempty" json:"account_type,omitempty"`
-	BankCode            string                 `url:"bank_code,omitempty" json:"bank_code,omitempty"`
-	BranchCode          string                 `url:"branch_code,omitempty" json:"branch_code,omitempty"`
-	CountryCode         string                 `url:"country_code,omitempty" json:"country_code,omitempty"`
-	Currency            string                 `url:"currency,omitempty" json:"currency,omitempty"`
-	Iban                string                 `url:"iban,omitempty" json:"iban,omitempty"`
-	Metadata            map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
+	AccountHolderName   string            `url:"account_holder_name,omitempty" json:"account_holder_name,omitempty"`
+	AccountNumber       string            `url:"account_number,omitempty" json:"account_number,omitempty"`
+	AccountNumberSuffix string            `url:"account_number_suffix,omitempty" json:"account_number_suffix,omitempty"`
+	AccountType         string            `url:"account_type,omitempty" json:"account_type,omitempty"`
+	BankCode            string            `url:"bank_code,omitempty" json:"bank_code,omitempty"`
+	BranchCode          string            `url:"branch_code,omitempty" json:"branch_code,omitempty"`
+	CountryCode         string            `url:"country_code,omitempty" json:"country_code,omitempty"`
+	Currency            string            `url:"currency,omitempty" json:"currency,omitempty"`
+	Iban                string            `url:"iban,omitempty" json:"iban,omitempty"`
+	Metadata            *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 // CollectBankAccount
@@ -798,6 +824,10 @@ type BillingRequestCollectBankAccountParams struct {
 // customer is requested to adjust the account number/routing number and
 // succeed in this check to continue with the flow.
 func (s *BillingRequestServiceImpl) CollectBankAccount(ctx context.Context, identity string, p BillingRequestCollectBankAccountParams, opts ...RequestOption) (*BillingRequest, error) {
+	if err := validateParams("data", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/billing_requests/%v/actions/collect_bank_account",
 		identity))
 	if err != nil {
@@ -891,13 +921,17 @@ func (s *BillingRequestServiceImpl) CollectBankAccount(ctx context.Context, iden
 
 // BillingRequestFulfilParams parameters
 type BillingRequestFulfilParams struct {
-	Metadata map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 // Fulfil
 // If a billing request is ready to be fulfilled, call this endpoint to cause
 // it to fulfil, executing the payment.
 func (s *BillingRequestServiceImpl) Fulfil(ctx context.Context, identity string, p BillingRequestFulfilParams, opts ...RequestOption) (*BillingRequest, error) {
+	if err := validateParams("data", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/billing_requests/%v/actions/fulfil",
 		identity))
 	if err != nil {
@@ -991,8 +1025,8 @@ func (s *BillingRequestServiceImpl) Fulfil(ctx context.Context, identity string,
 
 // BillingRequestChooseCurrencyParams parameters
 type BillingRequestChooseCurrencyParams struct {
-	Currency string                 `url:"currency,omitempty" json:"currency,omitempty"`
-	Metadata map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Currency string            `url:"currency,omitempty" json:"currency,omitempty"`
+	Metadata *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 // ChooseCurrency
@@ -1004,6 +1038,10 @@ type BillingRequestChooseCurrencyParams struct {
 // Flow. It
 // will also not support any request which has a payments request.
 func (s *BillingRequestServiceImpl) ChooseCurrency(ctx context.Context, identity string, p BillingRequestChooseCurrencyParams, opts ...RequestOption) (*BillingRequest, error) {
+	if err := validateParams("data", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/billing_requests/%v/actions/choose_currency",
 		identity))
 	if err != nil {
@@ -1097,7 +1135,7 @@ func (s *BillingRequestServiceImpl) ChooseCurrency(ctx context.Context, identity
 
 // BillingRequestConfirmPayerDetailsParams parameters
 type BillingRequestConfirmPayerDetailsParams struct {
-	Metadata map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 // ConfirmPayerDetails
@@ -1105,6 +1143,10 @@ type BillingRequestConfirmPayerDetailsParams struct {
 // we are required to
 // allow the payer to crosscheck the details entered by them and confirm it.
 func (s *BillingRequestServiceImpl) ConfirmPayerDetails(ctx context.Context, identity string, p BillingRequestConfirmPayerDetailsParams, opts ...RequestOption) (*BillingRequest, error) {
+	if err := validateParams("data", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/billing_requests/%v/actions/confirm_payer_details",
 		identity))
 	if err != nil {
@@ -1198,13 +1240,17 @@ func (s *BillingRequestServiceImpl) ConfirmPayerDetails(ctx context.Context, ide
 
 // BillingRequestCancelParams parameters
 type BillingRequestCancelParams struct {
-	Metadata map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 // Cancel
 // Immediately cancels a billing request, causing all billing request flows
 // to expire.
 func (s *BillingRequestServiceImpl) Cancel(ctx context.Context, identity string, p BillingRequestCancelParams, opts ...RequestOption) (*BillingRequest, error) {
+	if err := validateParams("data", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/billing_requests/%v/actions/cancel",
 		identity))
 	if err != nil {
@@ -25,23 +25,25 @@ type BillingRequestTemplateServiceImpl struct {
 
 // BillingRequestTemplate model
 type BillingRequestTemplate struct {
-	AuthorisationUrl          string                 `url:"authorisation_url,omitempty" json:"authorisation_url,omitempty"`
-	CreatedAt                 string                 `url:"created_at,omitempty" json:"created_at,omitempty"`
-	Id                        string                 `url:"id,omitempty" json:"id,omitempty"`
-	MandateRequestCurrency    string                 `url:"mandate_request_currency,omitempty" json:"mandate_request_currency,omitempty"`
-	MandateRequestDescription string                 `url:"mandate_request_description,omitempty" json:"mandate_request_description,omitempty"`
-	MandateRequestMetadata    map[string]interface{} `url:"mandate_request_metadata,omitempty" json:"mandate_request_metadata,omitempty"`
-	MandateRequestScheme      string                 `url:"mandate_request_scheme,omitempty" json:"mandate_request_scheme,omitempty"`
-	MandateRequestVerify      string                 `url:"mandate_request_verify,omitempty" json:"mandate_request_verify,omitempty"`
-	Metadata                  map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	Name                      string                 `url:"name,omitempty" json:"name,omitempty"`
-	PaymentRequestAmount      int                    `url:"payment_request_amount,omitempty" json:"payment_request_amount,omitempty"`
-	PaymentRequestCurrency    string                 `url:"payment_request_currency,omitempty" json:"payment_request_currency,omitempty"`
-	PaymentRequestDescription string                 `url:"payment_request_description,omitempty" json:"payment_request_description,omitempty"`
-	PaymentRequestMetadata    map[string]interface{} `url:"payment_request_metadata,omitempty" json:"payment_request_metadata,omitempty"`
-	PaymentRequestScheme      string                 `url:"payment_request_scheme,omitempty" json:"payment_request_scheme,omitempty"`
-	RedirectUri               string                 `url:"redirect_uri,omitempty" json:"redirect_uri,omitempty"`
-	UpdatedAt                 string                 `url:"updated_at,omitempty" json:"updated_at,omitempty"`
+	AuthorisationUrl          string                     `url:"authorisation_url,omitempty" json:"authorisation_url,omitempty"`
+	CreatedAt                 *Timestamp                 `url:"created_at,omitempty" json:"created_at,omitempty"`
+	Id                        string                     `url:"id,omitempty" json:"id,omitempty"`
+	MandateRequestCurrency    string                     `url:"mandate_request_currency,omitempty" json:"mandate_request_currency,omitempty"`
+	MandateRequestDescription string                     `url:"mandate_request_description,omitempty" json:"mandate_request_description,omitempty"`
+	MandateRequestMetadata    Metadata                   `url:"mandate_request_metadata,omitempty" json:"mandate_request_metadata,omitempty"`
+	MandateRequestScheme      string                     `url:"mandate_request_scheme,omitempty" json:"mandate_request_scheme,omitempty"`
+	MandateRequestVerify      string                     `url:"mandate_request_verify,omitempty" json:"mandate_request_verify,omitempty"`
+	Metadata                  Metadata                   `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Name                      string                     `url:"name,omitempty" json:"name,omitempty"`
+	PaymentRequestAmount      int                        `url:"payment_request_amount,omitempty" json:"payment_request_amount,omitempty"`
+	PaymentRequestCurrency    string                     `url:"payment_request_currency,omitempty" json:"payment_request_currency,omitempty"`
+	PaymentRequestDescription string                     `url:"payment_request_description,omitempty" json:"payment_request_description,omitempty"`
+	PaymentRequestMetadata    Metadata                   `url:"payment_request_metadata,omitempty" json:"payment_request_metadata,omitempty"`
+	PaymentRequestScheme      string                     `url:"payment_request_scheme,omitempty" json:"payment_request_scheme,omitempty"`
+	RedirectUri               string                     `url:"redirect_uri,omitempty" json:"redirect_uri,omitempty"`
+	UpdatedAt                 *Timestamp                 `url:"updated_at,omitempty" json:"updated_at,omitempty"`
+	Extra                     map[string]json.RawMessage `url:"-" json:"-"`
+	raw                       json.RawMessage
 }
 
 type BillingRequestTemplateService interface {
@@ -370,15 +372,15 @@ type BillingRequestTemplateCreateParams struct {
 	Links                     *BillingRequestTemplateCreateParamsLinks `url:"links,omitempty" json:"links,omitempty"`
 	MandateRequestCurrency    string                                   `url:"mandate_request_currency,omitempty" json:"mandate_request_currency,omitempty"`
 	MandateRequestDescription string                                   `url:"mandate_request_description,omitempty" json:"mandate_request_description,omitempty"`
-	MandateRequestMetadata    map[string]interface{}                   `url:"mandate_request_metadata,omitempty" json:"mandate_request_metadata,omitempty"`
+	MandateRequestMetadata    Metadata                                 `url:"mandate_request_metadata,omitempty" json:"mandate_request_metadata,omitempty"`
 	MandateRequestScheme      string                                   `url:"mandate_request_scheme,omitempty" json:"mandate_request_scheme,omitempty"`
 	MandateRequestVerify      string                                   `url:"mandate_request_verify,omitempty" json:"mandate_request_verify,omitempty"`
-	Metadata                  map[string]interface{}                   `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata                  Metadata                                 `url:"metadata,omitempty" json:"metadata,omitempty"`
 	Name                      string                                   `url:"name,omitempty" json:"name,omitempty"`
 	PaymentRequestAmount      int                                      `url:"payment_request_amount,omitempty" json:"payment_request_amount,omitempty"`
 	PaymentRequestCurrency    string                                   `url:"payment_request_currency,omitempty" json:"payment_request_currency,omitempty"`
 	PaymentRequestDescription string                                   `url:"payment_request_description,omitempty" json:"payment_request_description,omitempty"`
-	PaymentRequestMetadata    map[string]interface{}                   `url:"payment_request_metadata,omitempty" json:"payment_request_metadata,omitempty"`
+	PaymentRequestMetadata    Metadata                                 `url:"payment_request_metadata,omitempty" json:"payment_request_metadata,omitempty"`
 	PaymentRequestScheme      string                                   `url:"payment_request_scheme,omitempty" json:"payment_request_scheme,omitempty"`
 	RedirectUri               string                                   `url:"redirect_uri,omitempty" json:"redirect_uri,omitempty"`
 }
@@ -386,6 +388,10 @@ type BillingRequestTemplateCreateParams struct {
 // Create
 //
 func (s *BillingRequestTemplateServiceImpl) Create(ctx context.Context, p BillingRequestTemplateCreateParams, opts ...RequestOption) (*BillingRequestTemplate, error) {
+	if err := validateParams("billing_request_templates", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/billing_request_templates"))
 	if err != nil {
 		return nil, err
@@ -478,25 +484,29 @@ func (s *BillingRequestTemplateServiceImpl) Create(ctx context.Context, p Billin
 
 // BillingRequestTemplateUpdateParams parameters
 type BillingRequestTemplateUpdateParams struct {
-	MandateRequestCurrency    string                 `url:"mandate_request_currency,omitempty" json:"mandate_request_currency,omitempty"`
-	MandateRequestDescription string                 `url:"mandate_request_description,omitempty" json:"mandate_request_description,omitempty"`
-	MandateRequestMetadata    map[string]interface{} `url:"mandate_request_metadata,omitempty" json:"mandate_request_metadata,omitempty"`
-	MandateRequestScheme      string                 `url:"mandate_request_scheme,omitempty" json:"mandate_request_scheme,omitempty"`
-	MandateRequestVerify      string                 `url:"mandate_request_verify,omitempty" json:"mandate_request_verify,omitempty"`
-	Metadata                  map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	Name                      string                 `url:"name,omitempty" json:"name,omitempty"`
-	PaymentRequestAmount      int                    `url:"payment_request_amount,omitempty" json:"payment_request_amount,omitempty"`
-	PaymentRequestCurrency    string                 `url:"payment_request_currency,omitempty" json:"payment_request_currency,omitempty"`
-	PaymentRequestDescription string                 `url:"payment_request_description,omitempty" json:"payment_request_description,omitempty"`
-	PaymentRequestMetadata    map[string]interface{} `url:"payment_request_metadata,omitempty" json:"payment_request_metadata,omitempty"`
-	PaymentRequestScheme      string                 `url:"payment_request_scheme,omitempty" json:"payment_request_scheme,omitempty"`
-	RedirectUri               string                 `url:"redirect_uri,omitempty" json:"redirect_uri,omitempty"`
+	MandateRequestCurrency    *OptionalString   `url:"mandate_request_currency,omitempty" json:"mandate_request_currency,omitempty"`
+	MandateRequestDescription *OptionalString   `url:"mandate_request_description,omitempty" json:"mandate_request_description,omitempty"`
+	MandateRequestMetadata    *OptionalMetadata `url:"mandate_request_metadata,omitempty" json:"mandate_request_metadata,omitempty"`
+	MandateRequestScheme      *OptionalString   `url:"mandate_request_scheme,omitempty" json:"mandate_request_scheme,omitempty"`
+	MandateRequestVerify      *OptionalString   `url:"mandate_request_verify,omitempty" json:"mandate_request_verify,omitempty"`
+	Metadata                  *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Name                      *OptionalString   `url:"name,omitempty" json:"name,omitempty"`
+	PaymentRequestAmount      *OptionalInt      `url:"payment_request_amount,omitempty" json:"payment_request_amount,omitempty"`
+	PaymentRequestCurrency    *OptionalString   `url:"payment_request_currency,omitempty" json:"payment_request_currency,omitempty"`
+	PaymentRequestDescription *OptionalString   `url:"payment_request_description,omitempty" json:"payment_request_description,omitempty"`
+	PaymentRequestMetadata    *OptionalMetadata `url:"payment_request_metadata,omitempty" json:"payment_request_metadata,omitempty"`
+	PaymentRequestScheme      *OptionalString   `url:"payment_request_scheme,omitempty" json:"payment_request_scheme,omitempty"`
+	RedirectUri               *OptionalString   `url:"redirect_uri,omitempty" json:"redirect_uri,omitempty"`
 }
 
 // Update
 // Updates a Billing Request Template, which will affect all future Billing
 // Requests created by this template.
 func (s *BillingRequestTemplateServiceImpl) Update(ctx context.Context, identity string, p BillingRequestTemplateUpdateParams, opts ...RequestOption) (*BillingRequestTemplate, error) {
+	if err := validateParams("billing_request_templates", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/billing_request_templates/%v",
 		identity))
 	if err != nil {
@@ -25,14 +25,16 @@ type BlockServiceImpl struct {
 
 // Block model
 type Block struct {
-	Active            bool   `url:"active,omitempty" json:"active,omitempty"`
-	BlockType         string `url:"block_type,omitempty" json:"block_type,omitempty"`
-	CreatedAt         string `url:"created_at,omitempty" json:"created_at,omitempty"`
-	Id                string `url:"id,omitempty" json:"id,omitempty"`
-	ReasonDescription string `url:"reason_description,omitempty" json:"reason_description,omitempty"`
-	ReasonType        string `url:"reason_type,omitempty" json:"reason_type,omitempty"`
-	ResourceReference string `url:"resource_reference,omitempty" json:"resource_reference,omitempty"`
-	UpdatedAt         string `url:"updated_at,omitempty" json:"updated_at,omitempty"`
+	Active            bool                       `url:"active,omitempty" json:"active,omitempty"`
+	BlockType         string                     `url:"block_type,omitempty" json:"block_type,omitempty"`
+	CreatedAt         *Timestamp                 `url:"created_at,omitempty" json:"created_at,omitempty"`
+	Id                string                     `url:"id,omitempty" json:"id,omitempty"`
+	ReasonDescription string                     `url:"reason_description,omitempty" json:"reason_description,omitempty"`
+	ReasonType        string                     `url:"reason_type,omitempty" json:"reason_type,omitempty"`
+	ResourceReference string                     `url:"resource_reference,omitempty" json:"resource_reference,omitempty"`
+	UpdatedAt         *Timestamp                 `url:"updated_at,omitempty" json:"updated_at,omitempty"`
+	Extra             map[string]json.RawMessage `url:"-" json:"-"`
+	raw               json.RawMessage
 }
 
 type BlockService interface {
@@ -58,6 +60,10 @@ type BlockCreateParams struct {
 // Create
 // Creates a new Block of a given type. By default it will be active.
 func (s *BlockServiceImpl) Create(ctx context.Context, p BlockCreateParams, opts ...RequestOption) (*Block, error) {
+	if err := validateParams("blocks", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/blocks"))
 	if err != nil {
 		return nil, err
@@ -230,14 +236,14 @@ func (s *BlockServiceImpl) Get(ctx context.Context, identity string, opts ...Req
 
 // BlockListParams parameters
 type BlockListParams struct {
-	After      string `url:"after,omitempty" json:"after,omitempty"`
-	Before     string `url:"before,omitempty" json:"before,omitempty"`
-	Block      string `url:"block,omitempty" json:"block,omitempty"`
-	BlockType  string `url:"block_type,omitempty" json:"block_type,omitempty"`
-	CreatedAt  string `url:"created_at,omitempty" json:"created_at,omitempty"`
-	Limit      int    `url:"limit,omitempty" json:"limit,omitempty"`
-	ReasonType string `url:"reason_type,omitempty" json:"reason_type,omitempty"`
-	UpdatedAt  string `url:"updated_at,omitempty" json:"updated_at,omitempty"`
+	After      string     `url:"after,omitempty" json:"after,omitempty"`
+	Before     string     `url:"before,omitempty" json:"before,omitempty"`
+	Block      string     `url:"block,omitempty" json:"block,omitempty"`
+	BlockType  string     `url:"block_type,omitempty" json:"block_type,omitempty"`
+	CreatedAt  *Timestamp `url:"created_at,omitempty" json:"created_at,omitempty"`
+	Limit      int        `url:"limit,omitempty" json:"limit,omitempty"`
+	ReasonType string     `url:"reason_type,omitempty" json:"reason_type,omitempty"`
+	UpdatedAt  *Timestamp `url:"updated_at,omitempty" json:"updated_at,omitempty"`
 }
 
 type BlockListResultMetaCursors struct {
@@ -0,0 +1,130 @@
+package chargedate
+
+import (
+	"bufio"
+	"embed"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	gocardless "github.com/gocardless/gocardless-pro-go/v2"
+)
+
+// Names of the embedded calendars.
+const (
+	UK      = "uk"
+	TARGET2 = "target2"
+	SE      = "se"
+	DK      = "dk"
+	AU      = "au"
+	NZ      = "nz"
+	CA      = "ca"
+	US      = "us"
+)
+
+//go:embed calendars/*.txt
+var calendars embed.FS
+
+// Calendar is a set of holidays on which payments aren't processed. Weekends
+// are never working days.
+type Calendar struct {
+	Name string
+
+	holidays map[gocardless.Date]string
+	// first and last are the years the calendar holds holidays for.
+	first, last int
+}
+
+// NewCalendar returns an empty calendar.
+func NewCalendar(name string) *Calendar {
+	return &Calendar{Name: name, holidays: map[gocardless.Date]string{}}
+}
+
+// LoadCalendar returns a copy of the embedded calendar with the given name,
+// e.g. UK.
+func LoadCalendar(name string) (*Calendar, error) {
+	f, err := calendars.Open("calendars/" + name + ".txt")
+	if err != nil {
+		return nil, fmt.Errorf("unknown calendar %q", name)
+	}
+	defer f.Close()
+	return ParseCalendar(name, f)
+}
+
+// ParseCalendar reads a calendar holding a holiday per line, as a date
+// followed by its name, e.g. "2025-12-25 Christmas Day". Empty lines and
+// lines starting with '#' are ignored.
+func ParseCalendar(name string, r io.Reader) (*Calendar, error) {
+	c := NewCalendar(name)
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		text := strings.TrimSpace(s.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.SplitN(text, " ", 2)
+		d, err := gocardless.ParseDate(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("%s calendar line %d: %v", name, line, err)
+		}
+		holiday := ""
+		if len(fields) == 2 {
+			holiday = strings.TrimSpace(fields[1])
+		}
+		c.Add(d, holiday)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Add adds a holiday to the calendar.
+func (c *Calendar) Add(d gocardless.Date, name string) {
+	c.holidays[d] = name
+	if c.first == 0 || d.Year < c.first {
+		c.first = d.Year
+	}
+	if d.Year > c.last {
+		c.last = d.Year
+	}
+}
+
+// Covers reports whether the calendar holds the holidays of the year of d.
+func (c *Calendar) Covers(d gocardless.Date) bool {
+	return d.Year >= c.first && d.Year <= c.last
+}
+
+// Holiday returns the name of the holiday on d, if any.
+func (c *Calendar) Holiday(d gocardless.Date) (string, bool) {
+	name, ok := c.holidays[d]
+	return name, ok
+}
+
+// IsWorkingDay reports whether d is neither a weekend nor a holiday.
+func (c *Calendar) IsWorkingDay(d gocardless.Date) bool {
+	if wd := d.In(time.UTC).Weekday(); wd == time.Saturday || wd == time.Sunday {
+		return false
+	}
+	_, ok := c.holidays[d]
+	return !ok
+}
+
+// Roll returns d if it is a working day, or the next working day.
+func (c *Calendar) Roll(d gocardless.Date) gocardless.Date {
+	for !c.IsWorkingDay(d) {
+		d = d.AddDays(1)
+	}
+	return d
+}
+
+// AddWorkingDays returns the date n working days after d. Counting from a
+// non-working day starts at the next working day.
+func (c *Calendar) AddWorkingDays(d gocardless.Date, n int) gocardless.Date {
+	d = c.Roll(d)
+	for ; n > 0; n-- {
+		d = c.Roll(d.AddDays(1))
+	}
+	return d
+}
@@ -0,0 +1,58 @@
+package chargedate
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	gocardless "github.com/gocardless/gocardless-pro-go/v2"
+)
+
+func TestEmbeddedCalendars(t *testing.T) {
+	for _, name := range []string{UK, TARGET2, SE, DK, AU, NZ, CA, US} {
+		cal, err := LoadCalendar(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := cal.Holiday(gocardless.Date{Year: 2026, Month: time.December, Day: 25}); !ok {
+			t.Fatalf("Expected Christmas to be a holiday in %s", name)
+		}
+		if !cal.Covers(gocardless.Date{Year: 2030, Month: time.January, Day: 1}) {
+			t.Fatalf("Expected %s calendar to cover 2030", name)
+		}
+	}
+	if _, err := LoadCalendar("xx"); err == nil {
+		t.Fatal("Expected error for unknown calendar")
+	}
+}
+
+func TestCalendarWorkingDays(t *testing.T) {
+	cal, err := LoadCalendar(UK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Christmas 2026 is on a Friday and Boxing Day is substituted on Monday.
+	christmas := gocardless.Date{Year: 2026, Month: time.December, Day: 25}
+	if cal.IsWorkingDay(christmas) {
+		t.Fatal("Expected Christmas not to be a working day")
+	}
+	if got := cal.Roll(christmas).String(); got != "2026-12-29" {
+		t.Fatalf("Expected %q, got %q", "2026-12-29", got)
+	}
+	if got := cal.AddWorkingDays(gocardless.Date{Year: 2026, Month: time.December, Day: 24}, 2).String(); got != "2026-12-30" {
+		t.Fatalf("Expected %q, got %q", "2026-12-30", got)
+	}
+}
+
+func TestParseCalendar(t *testing.T) {
+	cal, err := ParseCalendar("custom", strings.NewReader("# comment\n\n2031-01-01 New Year's Day\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name, ok := cal.Holiday(gocardless.Date{Year: 2031, Month: time.January, Day: 1}); !ok || name != "New Year's Day" {
+		t.Fatalf("Unexpected holiday %q", name)
+	}
+	if _, err := ParseCalendar("custom", strings.NewReader("2031-13-01\n")); err == nil {
+		t.Fatal("Expected error for invalid date")
+	}
+}
@@ -0,0 +1,183 @@
+// Package chargedate works out the charge dates payments can be collected on
+// for each Direct Debit scheme, offline, from embedded bank holiday
+// calendars.
+package chargedate
+
+import (
+	"errors"
+	"fmt"
+
+	gocardless "github.com/gocardless/gocardless-pro-go/v2"
+)
+
+// Schedule is how a scheme collects payments: the calendar of its working
+// days, and the number of working days between creating a payment and the
+// earliest date it can be charged on.
+type Schedule struct {
+	Calendar *Calendar
+	LeadTime int
+}
+
+// defaultSchedules maps the Direct Debit schemes to their embedded calendar
+// and lead time.
+var defaultSchedules = map[gocardless.Scheme]struct {
+	calendar string
+	leadTime int
+}{
+	gocardless.SchemeBacs:             {UK, 3},
+	gocardless.SchemeSepaCore:         {TARGET2, 3},
+	gocardless.SchemeAutogiro:         {SE, 2},
+	gocardless.SchemeBetalingsservice: {DK, 8},
+	gocardless.SchemeBecs:             {AU, 2},
+	gocardless.SchemeBecsNz:           {NZ, 2},
+	gocardless.SchemePad:              {CA, 3},
+	gocardless.SchemeAch:              {US, 2},
+}
+
+// Calculator works out charge dates.
+type Calculator struct {
+	schedules map[gocardless.Scheme]Schedule
+}
+
+// CalculatorOption configures a Calculator.
+type CalculatorOption func(*Calculator) error
+
+// WithSchedule sets the calendar and lead time used for scheme, e.g. to use
+// an updated calendar.
+func WithSchedule(scheme gocardless.Scheme, s Schedule) CalculatorOption {
+	return func(c *Calculator) error {
+		if s.Calendar == nil {
+			return errors.New("missing calendar")
+		}
+		if s.LeadTime < 0 {
+			return errors.New("lead time must not be negative")
+		}
+		c.schedules[scheme] = s
+		return nil
+	}
+}
+
+// NewCalculator returns a Calculator for the Direct Debit schemes, using the
+// embedded calendars unless configured otherwise.
+func NewCalculator(opts ...CalculatorOption) (*Calculator, error) {
+	c := &Calculator{schedules: map[gocardless.Scheme]Schedule{}}
+	for scheme, d := range defaultSchedules {
+		cal, err := LoadCalendar(d.calendar)
+		if err != nil {
+			return nil, err
+		}
+		c.schedules[scheme] = Schedule{Calendar: cal, LeadTime: d.leadTime}
+	}
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// Schedule returns the schedule used for scheme.
+func (c *Calculator) Schedule(scheme gocardless.Scheme) (Schedule, error) {
+	s, ok := c.schedules[scheme]
+	if !ok {
+		return Schedule{}, fmt.Errorf("unsupported scheme %q", string(scheme))
+	}
+	return s, nil
+}
+
+// calendarFor returns the calendar of scheme, checking it holds the holidays
+// of the dates given.
+func (c *Calculator) calendarFor(scheme gocardless.Scheme, dates ...gocardless.Date) (*Calendar, error) {
+	s, err := c.Schedule(scheme)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range dates {
+		if !s.Calendar.Covers(d) {
+			return nil, fmt.Errorf("%s calendar doesn't cover %d", s.Calendar.Name, d.Year)
+		}
+	}
+	return s.Calendar, nil
+}
+
+// IsWorkingDay reports whether payments on scheme can be charged on d.
+func (c *Calculator) IsWorkingDay(scheme gocardless.Scheme, d gocardless.Date) (bool, error) {
+	cal, err := c.calendarFor(scheme, d)
+	if err != nil {
+		return false, err
+	}
+	return cal.IsWorkingDay(d), nil
+}
+
+// Roll returns d if payments on scheme can be charged on it, or the next
+// date they can, as the API does with charge dates.
+func (c *Calculator) Roll(scheme gocardless.Scheme, d gocardless.Date) (gocardless.Date, error) {
+	cal, err := c.calendarFor(scheme, d)
+	if err != nil {
+		return gocardless.Date{}, err
+	}
+	rolled := cal.Roll(d)
+	if !cal.Covers(rolled) {
+		return gocardless.Date{}, fmt.Errorf("%s calendar doesn't cover %d", cal.Name, rolled.Year)
+	}
+	return rolled, nil
+}
+
+// EarliestChargeDate returns the earliest charge date of a payment on
+// scheme created on today.
+func (c *Calculator) EarliestChargeDate(scheme gocardless.Scheme, today gocardless.Date) (gocardless.Date, error) {
+	s, err := c.Schedule(scheme)
+	if err != nil {
+		return gocardless.Date{}, err
+	}
+	earliest := s.Calendar.AddWorkingDays(today, s.LeadTime)
+	if _, err := c.calendarFor(scheme, today, earliest); err != nil {
+		return gocardless.Date{}, err
+	}
+	return earliest, nil
+}
+
+// EarliestChargeDateFor returns the earliest charge date of a payment
+// created on today against the mandate, which is never before its next
+// possible charge date.
+func (c *Calculator) EarliestChargeDateFor(m *gocardless.Mandate, today gocardless.Date) (gocardless.Date, error) {
+	earliest, err := c.EarliestChargeDate(m.Scheme, today)
+	if err != nil {
+		return gocardless.Date{}, err
+	}
+	if m.NextPossibleChargeDate != nil && m.NextPossibleChargeDate.After(earliest) {
+		return c.Roll(m.Scheme, *m.NextPossibleChargeDate)
+	}
+	return earliest, nil
+}
+
+// ValidatePayment checks the charge date of the params of a payment created
+// on today against the mandate. Charge dates on non-working days are rolled
+// forward as the API does. The error returned is an *APIError holding a
+// ValidationError, as returned by the API.
+func (c *Calculator) ValidatePayment(p gocardless.PaymentCreateParams, m *gocardless.Mandate, today gocardless.Date) error {
+	if p.ChargeDate == nil {
+		return nil
+	}
+	earliest, err := c.EarliestChargeDateFor(m, today)
+	if err != nil {
+		return err
+	}
+	rolled, err := c.Roll(m.Scheme, *p.ChargeDate)
+	if err != nil {
+		return err
+	}
+	if !rolled.Before(earliest) {
+		return nil
+	}
+	return &gocardless.APIError{
+		Message: "Validation failed",
+		Type:    "validation_failed",
+		Code:    422,
+		Errors: []gocardless.ValidationError{{
+			Field:          "charge_date",
+			Message:        fmt.Sprintf("must be on or after %s", earliest),
+			RequestPointer: "/payments/charge_date",
+		}},
+	}
+}
@@ -0,0 +1,90 @@
+package chargedate
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	gocardless "github.com/gocardless/gocardless-pro-go/v2"
+)
+
+func TestEarliestChargeDate(t *testing.T) {
+	c, err := NewCalculator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Friday 3 April 2026 is Good Friday, and Monday 6 April Easter Monday
+	// in the UK and TARGET2 but not in the US.
+	today := gocardless.Date{Year: 2026, Month: time.April, Day: 2}
+	for scheme, expected := range map[gocardless.Scheme]string{
+		gocardless.SchemeBacs:     "2026-04-09",
+		gocardless.SchemeSepaCore: "2026-04-09",
+		gocardless.SchemeAch:      "2026-04-06",
+	} {
+		d, err := c.EarliestChargeDate(scheme, today)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.String() != expected {
+			t.Fatalf("Expected %s earliest charge date %q, got %q", scheme, expected, d)
+		}
+	}
+
+	if _, err := c.EarliestChargeDate(gocardless.SchemeFasterPayments, today); err == nil {
+		t.Fatal("Expected error for unsupported scheme")
+	}
+	if _, err := c.EarliestChargeDate(gocardless.SchemeBacs, gocardless.Date{Year: 2040, Month: time.January, Day: 2}); err == nil {
+		t.Fatal("Expected error for uncovered year")
+	}
+}
+
+func TestWithSchedule(t *testing.T) {
+	cal := NewCalendar("custom")
+	cal.Add(gocardless.Date{Year: 2026, Month: time.April, Day: 3}, "")
+	c, err := NewCalculator(WithSchedule(gocardless.SchemeBacs, Schedule{Calendar: cal, LeadTime: 1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := c.EarliestChargeDate(gocardless.SchemeBacs, gocardless.Date{Year: 2026, Month: time.April, Day: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.String() != "2026-04-06" {
+		t.Fatalf("Expected %q, got %q", "2026-04-06", d)
+	}
+	if _, err := NewCalculator(WithSchedule(gocardless.SchemeBacs, Schedule{})); err == nil {
+		t.Fatal("Expected error for missing calendar")
+	}
+}
+
+func TestValidatePayment(t *testing.T) {
+	c, err := NewCalculator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	today := gocardless.Date{Year: 2026, Month: time.October, Day: 19}
+	m := &gocardless.Mandate{
+		Scheme:                 gocardless.SchemeBacs,
+		NextPossibleChargeDate: gocardless.NewDate(2026, time.October, 24),
+	}
+
+	// The next possible charge date is a Saturday, so the earliest is Monday.
+	p := gocardless.PaymentCreateParams{ChargeDate: gocardless.NewDate(2026, time.October, 23)}
+	err = c.ValidatePayment(p, m, today)
+	var apiErr *gocardless.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %v", err)
+	}
+	expected := "must be on or after 2026-10-26"
+	if apiErr.Errors[0].Message != expected {
+		t.Fatalf("Expected %q, got %q", expected, apiErr.Errors[0].Message)
+	}
+
+	p.ChargeDate = gocardless.NewDate(2026, time.October, 25)
+	if err := c.ValidatePayment(p, m, today); err != nil {
+		t.Fatalf("Expected Sunday to be rolled to Monday, got %v", err)
+	}
+	if err := c.ValidatePayment(gocardless.PaymentCreateParams{}, m, today); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,235 @@
+package chargedate
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	gocardless "github.com/gocardless/gocardless-pro-go/v2"
+)
+
+// currencySchemes maps currencies to the Direct Debit scheme subscriptions
+// in that currency are collected with.
+var currencySchemes = map[gocardless.Currency]gocardless.Scheme{
+	gocardless.CurrencyAUD: gocardless.SchemeBecs,
+	gocardless.CurrencyCAD: gocardless.SchemePad,
+	gocardless.CurrencyDKK: gocardless.SchemeBetalingsservice,
+	gocardless.CurrencyEUR: gocardless.SchemeSepaCore,
+	gocardless.CurrencyGBP: gocardless.SchemeBacs,
+	gocardless.CurrencyNZD: gocardless.SchemeBecsNz,
+	gocardless.CurrencySEK: gocardless.SchemeAutogiro,
+	gocardless.CurrencyUSD: gocardless.SchemeAch,
+}
+
+// SchemeForCurrency returns the Direct Debit scheme payments in currency are
+// collected with.
+func SchemeForCurrency(currency gocardless.Currency) (gocardless.Scheme, error) {
+	scheme, ok := currencySchemes[currency]
+	if !ok {
+		return "", fmt.Errorf("unsupported currency %q", string(currency))
+	}
+	return scheme, nil
+}
+
+var (
+	defaultCalculator     *Calculator
+	defaultCalculatorErr  error
+	defaultCalculatorOnce sync.Once
+)
+
+// ProjectSchedule returns the payments of a subscription charged from from
+// up to and including until, using the embedded calendars. See
+// Calculator.ProjectSchedule.
+func ProjectSchedule(subscription interface{}, from, until gocardless.Date) ([]gocardless.SubscriptionUpcomingPayments, error) {
+	defaultCalculatorOnce.Do(func() {
+		defaultCalculator, defaultCalculatorErr = NewCalculator()
+	})
+	if defaultCalculatorErr != nil {
+		return nil, defaultCalculatorErr
+	}
+	return defaultCalculator.ProjectSchedule(subscription, from, until)
+}
+
+// recurrence holds the fields of a subscription its charge dates depend on.
+type recurrence struct {
+	amount       int
+	currency     string
+	count        int
+	dayOfMonth   int
+	interval     int
+	intervalUnit gocardless.IntervalUnit
+	month        string
+	startDate    *gocardless.Date
+	endDate      *gocardless.Date
+	// earliest is the earliest charge date after a subscription resumes.
+	earliest *gocardless.Date
+}
+
+// maxRollDays bounds how far rolling a date to a working day moves it.
+const maxRollDays = 7
+
+// ProjectSchedule returns the payments of a subscription charged from from
+// up to and including until. The subscription is given as a
+// gocardless.SubscriptionCreateParams or a *gocardless.Subscription, and
+// must have a start date. Charge dates follow the rules of the API: payments
+// are charged on the day of month, or the day of the start date, and dates
+// on non-working days of the scheme of the currency are rolled forward. No
+// payment is charged on or after the end date.
+//
+// Subscriptions which have ended have no payments left. Pauses aren't taken
+// into account, except that a payment due before the
+// EarliestChargeDateAfterResume of a subscription is charged on that date.
+//
+// Both from and until are taken, rather than until alone, so that a
+// subscription which started years ago neither returns the payments already
+// charged nor needs calendars for the years since. The count of the
+// subscription includes the payments charged before from, and only the
+// calendars of the years of the payments returned are needed.
+func (c *Calculator) ProjectSchedule(subscription interface{}, from, until gocardless.Date) ([]gocardless.SubscriptionUpcomingPayments, error) {
+	var r recurrence
+	switch s := subscription.(type) {
+	case gocardless.SubscriptionCreateParams:
+		r = recurrence{s.Amount, s.Currency, s.Count, s.DayOfMonth, s.Interval, s.IntervalUnit, s.Month, s.StartDate, s.EndDate, nil}
+	case *gocardless.SubscriptionCreateParams:
+		if s == nil {
+			return nil, fmt.Errorf("unsupported subscription %T", subscription)
+		}
+		return c.ProjectSchedule(*s, from, until)
+	case *gocardless.Subscription:
+		if s == nil {
+			return nil, fmt.Errorf("unsupported subscription %T", subscription)
+		}
+		if s.Status.IsTerminal() {
+			return nil, nil
+		}
+		r = recurrence{s.Amount, s.Currency, s.Count, s.DayOfMonth, s.Interval, s.IntervalUnit, s.Month, s.StartDate, s.EndDate, s.EarliestChargeDateAfterResume}
+	default:
+		return nil, fmt.Errorf("unsupported subscription %T", subscription)
+	}
+
+	scheme, err := SchemeForCurrency(gocardless.Currency(r.currency))
+	if err != nil {
+		return nil, err
+	}
+	sched, err := c.Schedule(scheme)
+	if err != nil {
+		return nil, err
+	}
+	cal := sched.Calendar
+
+	var payments []gocardless.SubscriptionUpcomingPayments
+	for i := 0; r.count == 0 || i < r.count; i++ {
+		d, err := r.nominalDate(i)
+		if err != nil {
+			return nil, err
+		}
+		if r.endDate != nil && !d.Before(*r.endDate) {
+			break
+		}
+		// Payments due before the earliest charge date after resuming are
+		// charged on it, once.
+		moved := r.earliest != nil && d.Before(*r.earliest)
+		if moved {
+			d = *r.earliest
+		}
+		if d.AddDays(maxRollDays).Before(from) {
+			continue
+		}
+		if d.After(until) {
+			break
+		}
+		if !cal.Covers(d) {
+			return nil, fmt.Errorf("%s calendar doesn't cover %d", cal.Name, d.Year)
+		}
+		charge := cal.Roll(d)
+		if n := len(payments); moved && n > 0 && *payments[n-1].ChargeDate == charge {
+			continue
+		}
+		if r.endDate != nil && !charge.Before(*r.endDate) {
+			break
+		}
+		if charge.Before(from) {
+			continue
+		}
+		if charge.After(until) {
+			break
+		}
+		if !cal.Covers(charge) {
+			return nil, fmt.Errorf("%s calendar doesn't cover %d", cal.Name, charge.Year)
+		}
+		payments = append(payments, gocardless.SubscriptionUpcomingPayments{
+			Amount:     r.amount,
+			ChargeDate: &charge,
+		})
+	}
+	return payments, nil
+}
+
+// nominalDate returns the date the i-th payment falls on, before rolling it
+// to a working day.
+func (r recurrence) nominalDate(i int) (gocardless.Date, error) {
+	if r.startDate == nil {
+		return gocardless.Date{}, errors.New("missing start date")
+	}
+	start := *r.startDate
+	interval := r.interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	switch r.intervalUnit {
+	case gocardless.IntervalUnitWeekly:
+		return start.AddDays(7 * interval * i), nil
+	case gocardless.IntervalUnitMonthly:
+		first := r.dayIn(start.Year, start.Month)
+		if first.Before(start) {
+			first = r.dayIn(start.Year, start.Month+1)
+		}
+		return r.dayIn(first.Year, first.Month+time.Month(interval*i)), nil
+	case gocardless.IntervalUnitYearly:
+		month := start.Month
+		if r.month != "" {
+			var err error
+			if month, err = parseMonth(r.month); err != nil {
+				return gocardless.Date{}, err
+			}
+		}
+		first := r.dayIn(start.Year, month)
+		if first.Before(start) {
+			first = r.dayIn(start.Year+1, month)
+		}
+		return r.dayIn(first.Year+interval*i, month), nil
+	}
+	return gocardless.Date{}, fmt.Errorf("unsupported interval unit %q", string(r.intervalUnit))
+}
+
+// dayIn returns the day of the month payments are charged on in the given
+// month, which may overflow into the following years. Days past the end of
+// the month fall on its last day.
+func (r recurrence) dayIn(year int, month time.Month) gocardless.Date {
+	first := gocardless.DateOf(time.Date(year, month, 1, 0, 0, 0, 0, time.UTC))
+	last := first.In(time.UTC).AddDate(0, 1, -1).Day()
+	day := r.dayOfMonth
+	switch {
+	case day == 0:
+		day = r.startDate.Day
+	case day < 0:
+		day = last
+	}
+	if day > last {
+		day = last
+	}
+	first.Day = day
+	return first
+}
+
+func parseMonth(s string) (time.Month, error) {
+	for m := time.January; m <= time.December; m++ {
+		if strings.EqualFold(m.String(), s) {
+			return m, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid month %q", s)
+}
@@ -0,0 +1,184 @@
+package chargedate
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	gocardless "github.com/gocardless/gocardless-pro-go/v2"
+)
+
+// target2For2014 holds the TARGET2 closing days of the fixtures' schedule.
+const target2For2014 = `
+2014-01-01 New Year's Day
+2014-04-18 Good Friday
+2014-04-21 Easter Monday
+2014-05-01 Labour Day
+2014-12-25 Christmas Day
+2014-12-26 Boxing Day
+2015-01-01 New Year's Day
+2015-04-03 Good Friday
+2015-04-06 Easter Monday
+2015-05-01 Labour Day
+2015-12-25 Christmas Day
+2015-12-26 Boxing Day
+`
+
+// TestProjectScheduleMatchesFixtures checks the projection against the
+// upcoming payments of the subscriptions in testdata/subscriptions.json. The
+// API lists only the next few payments, so they must start the projection.
+// The amounts of the fixtures' upcoming payments are example values which
+// don't match the amount of their subscription, so only charge dates are
+// compared.
+func TestProjectScheduleMatchesFixtures(t *testing.T) {
+	cal, err := ParseCalendar(TARGET2, strings.NewReader(target2For2014))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewCalculator(WithSchedule(gocardless.SchemeSepaCore, Schedule{Calendar: cal, LeadTime: 3}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile("../testdata/subscriptions.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fixtures map[string]struct {
+		Body struct {
+			Subscriptions json.RawMessage `json:"subscriptions"`
+		} `json:"body"`
+	}
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		t.Fatal(err)
+	}
+	for name, fixture := range fixtures {
+		var subscriptions []*gocardless.Subscription
+		if err := json.Unmarshal(fixture.Body.Subscriptions, &subscriptions); err != nil {
+			var s gocardless.Subscription
+			if err := json.Unmarshal(fixture.Body.Subscriptions, &s); err != nil {
+				t.Fatal(err)
+			}
+			subscriptions = append(subscriptions, &s)
+		}
+		for _, s := range subscriptions {
+			if len(s.UpcomingPayments) == 0 {
+				t.Fatalf("%s: Expected upcoming payments", name)
+			}
+			payments, err := c.ProjectSchedule(s, *s.StartDate, *s.EndDate)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if len(payments) < len(s.UpcomingPayments) {
+				t.Fatalf("%s: Expected at least %d payments, got %d", name, len(s.UpcomingPayments), len(payments))
+			}
+			for i, expected := range s.UpcomingPayments {
+				if *payments[i].ChargeDate != *expected.ChargeDate {
+					t.Fatalf("%s: Expected payment %d on %s, got %s", name, i, expected.ChargeDate, payments[i].ChargeDate)
+				}
+			}
+		}
+	}
+}
+
+// TestProjectScheduleGolden checks the schedules in testdata/schedules.json,
+// whose charge dates were worked out by hand from the published holidays.
+func TestProjectScheduleGolden(t *testing.T) {
+	data, err := os.ReadFile("testdata/schedules.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cases []struct {
+		Name             string                                    `json:"name"`
+		Subscription     *gocardless.Subscription                  `json:"subscription"`
+		From             gocardless.Date                           `json:"from"`
+		Until            gocardless.Date                           `json:"until"`
+		UpcomingPayments []gocardless.SubscriptionUpcomingPayments `json:"upcoming_payments"`
+	}
+	if err := json.Unmarshal(data, &cases); err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range cases {
+		payments, err := ProjectSchedule(tc.Subscription, tc.From, tc.Until)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.Name, err)
+		}
+		if len(payments) != len(tc.UpcomingPayments) {
+			t.Fatalf("Expected %d %s payments, got %d", len(tc.UpcomingPayments), tc.Name, len(payments))
+		}
+		for i, p := range payments {
+			expected := tc.UpcomingPayments[i]
+			if p.Amount != expected.Amount || *p.ChargeDate != *expected.ChargeDate {
+				t.Fatalf("Expected %s payment of %d on %s, got %d on %s", tc.Name, expected.Amount, expected.ChargeDate, p.Amount, p.ChargeDate)
+			}
+		}
+	}
+}
+
+func TestProjectSchedule(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		params   gocardless.SubscriptionCreateParams
+		expected []string
+	}{
+		{
+			name: "last day of month",
+			params: gocardless.SubscriptionCreateParams{
+				Currency:     "GBP",
+				IntervalUnit: gocardless.IntervalUnitMonthly,
+				DayOfMonth:   -1,
+				StartDate:    gocardless.NewDate(2026, time.January, 15),
+				Count:        4,
+			},
+			// 31 January and 28 February 2026 are on Saturdays.
+			expected: []string{"2026-02-02", "2026-03-02", "2026-03-31", "2026-04-30"},
+		},
+		{
+			name: "yearly",
+			params: gocardless.SubscriptionCreateParams{
+				Currency:     "GBP",
+				IntervalUnit: gocardless.IntervalUnitYearly,
+				Month:        "december",
+				DayOfMonth:   25,
+				StartDate:    gocardless.NewDate(2026, time.January, 1),
+			},
+			expected: []string{"2026-12-29", "2027-12-29", "2028-12-27"},
+		},
+		{
+			name: "fortnightly until end date",
+			params: gocardless.SubscriptionCreateParams{
+				Currency:     "USD",
+				Interval:     2,
+				IntervalUnit: gocardless.IntervalUnitWeekly,
+				StartDate:    gocardless.NewDate(2026, time.June, 5),
+				EndDate:      gocardless.NewDate(2026, time.July, 17),
+			},
+			// Juneteenth is on 19 June, and no payment is due on the end date.
+			expected: []string{"2026-06-05", "2026-06-22", "2026-07-03"},
+		},
+	} {
+		payments, err := ProjectSchedule(tc.params, gocardless.Date{Year: 2026, Month: time.January, Day: 1}, gocardless.Date{Year: 2028, Month: time.December, Day: 31})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, p := range payments {
+			got = append(got, p.ChargeDate.String())
+		}
+		if strings.Join(got, ",") != strings.Join(tc.expected, ",") {
+			t.Fatalf("Expected %s charge dates %v, got %v", tc.name, tc.expected, got)
+		}
+	}
+}
+
+func TestProjectScheduleEndedSubscription(t *testing.T) {
+	payments, err := ProjectSchedule(&gocardless.Subscription{Status: gocardless.SubscriptionStatusCancelled}, gocardless.Date{Year: 2026, Month: time.January, Day: 1}, gocardless.Date{Year: 2026, Month: time.January, Day: 1})
+	if err != nil || payments != nil {
+		t.Fatalf("Expected no payments, got %v, %v", payments, err)
+	}
+	if _, err := ProjectSchedule(gocardless.SubscriptionCreateParams{Currency: "GBP", IntervalUnit: gocardless.IntervalUnitMonthly}, gocardless.Date{Year: 2026, Month: time.January, Day: 1}, gocardless.Date{Year: 2026, Month: time.January, Day: 1}); err == nil {
+		t.Fatal("Expected error for missing start date")
+	}
+}
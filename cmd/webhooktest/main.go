@@ -0,0 +1,90 @@
+// Command webhooktest sends signed GoCardless webhooks to a local endpoint.
+//
+// Usage:
+//
+//	webhooktest -url http://localhost:8000/webhooks -secret secret -resource payments -action confirmed -id PM123
+//	webhooktest -url http://localhost:8000/webhooks -secret secret -file webhook.json
+//
+// With -file, the file is sent as is. It must hold a complete webhook body,
+// i.e. {"events":[...]}.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	gocardless "github.com/gocardless/gocardless-pro-go/v2"
+	"github.com/gocardless/gocardless-pro-go/v2/webhooktest"
+)
+
+func main() {
+	os.Exit(run())
+}
+
+// run sends the webhook and returns the exit code, so that deferred calls
+// run before exiting.
+func run() int {
+	var (
+		url          = flag.String("url", "", "URL of the webhook endpoint")
+		secret       = flag.String("secret", os.Getenv("GOCARDLESS_WEBHOOK_SECRET"), "webhook endpoint secret (defaults to $GOCARDLESS_WEBHOOK_SECRET)")
+		resourceType = flag.String("resource", "payments", "resource type of the event")
+		action       = flag.String("action", "confirmed", "action of the event")
+		resourceID   = flag.String("id", "", "ID of the resource the event links to")
+		file         = flag.String("file", "", "file holding the webhook body to send, instead of building one")
+		timeout      = flag.Duration("timeout", 10*time.Second, "request timeout")
+	)
+	flag.Parse()
+
+	if *url == "" || *secret == "" {
+		flag.Usage()
+		return 2
+	}
+
+	var body []byte
+	var err error
+	if *file != "" {
+		body, err = os.ReadFile(*file)
+	} else {
+		body, err = buildBody(gocardless.ResourceType(*resourceType), gocardless.EventAction(*action), *resourceID)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	res, err := webhooktest.SendBody(ctx, nil, *url, *secret, body)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer res.Body.Close()
+
+	fmt.Println(res.Status)
+	if _, err := io.Copy(os.Stdout, res.Body); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if res.StatusCode >= 300 {
+		return 1
+	}
+	return 0
+}
+
+func buildBody(resourceType gocardless.ResourceType, action gocardless.EventAction, resourceID string) ([]byte, error) {
+	event, err := webhooktest.NewEvent(resourceType, action, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	webhook, err := webhooktest.NewWebhook(event)
+	if err != nil {
+		return nil, err
+	}
+	return webhook.Body()
+}
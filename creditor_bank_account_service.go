@@ -24,22 +24,25 @@ type CreditorBankAccountServiceImpl struct {
 }
 
 type CreditorBankAccountLinks struct {
-	Creditor string `url:"creditor,omitempty" json:"creditor,omitempty"`
+	Creditor string                     `url:"creditor,omitempty" json:"creditor,omitempty"`
+	Extra    map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 // CreditorBankAccount model
 type CreditorBankAccount struct {
-	AccountHolderName   string                    `url:"account_holder_name,omitempty" json:"account_holder_name,omitempty"`
-	AccountNumberEnding string                    `url:"account_number_ending,omitempty" json:"account_number_ending,omitempty"`
-	AccountType         string                    `url:"account_type,omitempty" json:"account_type,omitempty"`
-	BankName            string                    `url:"bank_name,omitempty" json:"bank_name,omitempty"`
-	CountryCode         string                    `url:"country_code,omitempty" json:"country_code,omitempty"`
-	CreatedAt           string                    `url:"created_at,omitempty" json:"created_at,omitempty"`
-	Currency            string                    `url:"currency,omitempty" json:"currency,omitempty"`
-	Enabled             bool                      `url:"enabled,omitempty" json:"enabled,omitempty"`
-	Id                  string                    `url:"id,omitempty" json:"id,omitempty"`
-	Links               *CreditorBankAccountLinks `url:"links,omitempty" json:"links,omitempty"`
-	Metadata            map[string]interface{}    `url:"metadata,omitempty" json:"metadata,omitempty"`
+	AccountHolderName   string                     `url:"account_holder_name,omitempty" json:"account_holder_name,omitempty"`
+	AccountNumberEnding string                     `url:"account_number_ending,omitempty" json:"account_number_ending,omitempty"`
+	AccountType         string                     `url:"account_type,omitempty" json:"account_type,omitempty"`
+	BankName            string                     `url:"bank_name,omitempty" json:"bank_name,omitempty"`
+	CountryCode         string                     `url:"country_code,omitempty" json:"country_code,omitempty"`
+	CreatedAt           *Timestamp                 `url:"created_at,omitempty" json:"created_at,omitempty"`
+	Currency            string                     `url:"currency,omitempty" json:"currency,omitempty"`
+	Enabled             bool                       `url:"enabled,omitempty" json:"enabled,omitempty"`
+	Id                  string                     `url:"id,omitempty" json:"id,omitempty"`
+	Links               *CreditorBankAccountLinks  `url:"links,omitempty" json:"links,omitempty"`
+	Metadata            Metadata                   `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Extra               map[string]json.RawMessage `url:"-" json:"-"`
+	raw                 json.RawMessage
 }
 
 type CreditorBankAccountService interface {
@@ -65,13 +68,17 @@ type CreditorBankAccountCreateParams struct {
 	Currency                  string                               `url:"currency,omitempty" json:"currency,omitempty"`
 	Iban                      string                               `url:"iban,omitempty" json:"iban,omitempty"`
 	Links                     CreditorBankAccountCreateParamsLinks `url:"links,omitempty" json:"links,omitempty"`
-	Metadata                  map[string]interface{}               `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata                  Metadata                             `url:"metadata,omitempty" json:"metadata,omitempty"`
 	SetAsDefaultPayoutAccount bool                                 `url:"set_as_default_payout_account,omitempty" json:"set_as_default_payout_account,omitempty"`
 }
 
 // Create
 // Creates a new creditor bank account object.
 func (s *CreditorBankAccountServiceImpl) Create(ctx context.Context, p CreditorBankAccountCreateParams, opts ...RequestOption) (*CreditorBankAccount, error) {
+	if err := validateParams("creditor_bank_accounts", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/creditor_bank_accounts"))
 	if err != nil {
 		return nil, err
@@ -162,12 +169,7 @@ func (s *CreditorBankAccountServiceImpl) Create(ctx context.Context, p CreditorB
 	return result.CreditorBankAccount, nil
 }
 
-type CreditorBankAccountListParamsCreatedAt struct {
-	Gt  string `url:"gt,omitempty" json:"gt,omitempty"`
-	Gte string `url:"gte,omitempty" json:"gte,omitempty"`
-	Lt  string `url:"lt,omitempty" json:"lt,omitempty"`
-	Lte string `url:"lte,omitempty" json:"lte,omitempty"`
-}
+type CreditorBankAccountListParamsCreatedAt = TimestampRange
 
 // CreditorBankAccountListParams parameters
 type CreditorBankAccountListParams struct {
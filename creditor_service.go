@@ -24,32 +24,34 @@ type CreditorServiceImpl struct {
 }
 
 type CreditorLinks struct {
-	DefaultAudPayoutAccount string `url:"default_aud_payout_account,omitempty" json:"default_aud_payout_account,omitempty"`
-	DefaultCadPayoutAccount string `url:"default_cad_payout_account,omitempty" json:"default_cad_payout_account,omitempty"`
-	DefaultDkkPayoutAccount string `url:"default_dkk_payout_account,omitempty" json:"default_dkk_payout_account,omitempty"`
-	DefaultEurPayoutAccount string `url:"default_eur_payout_account,omitempty" json:"default_eur_payout_account,omitempty"`
-	DefaultGbpPayoutAccount string `url:"default_gbp_payout_account,omitempty" json:"default_gbp_payout_account,omitempty"`
-	DefaultNzdPayoutAccount string `url:"default_nzd_payout_account,omitempty" json:"default_nzd_payout_account,omitempty"`
-	DefaultSekPayoutAccount string `url:"default_sek_payout_account,omitempty" json:"default_sek_payout_account,omitempty"`
-	DefaultUsdPayoutAccount string `url:"default_usd_payout_account,omitempty" json:"default_usd_payout_account,omitempty"`
+	DefaultAudPayoutAccount string                     `url:"default_aud_payout_account,omitempty" json:"default_aud_payout_account,omitempty"`
+	DefaultCadPayoutAccount string                     `url:"default_cad_payout_account,omitempty" json:"default_cad_payout_account,omitempty"`
+	DefaultDkkPayoutAccount string                     `url:"default_dkk_payout_account,omitempty" json:"default_dkk_payout_account,omitempty"`
+	DefaultEurPayoutAccount string                     `url:"default_eur_payout_account,omitempty" json:"default_eur_payout_account,omitempty"`
+	DefaultGbpPayoutAccount string                     `url:"default_gbp_payout_account,omitempty" json:"default_gbp_payout_account,omitempty"`
+	DefaultNzdPayoutAccount string                     `url:"default_nzd_payout_account,omitempty" json:"default_nzd_payout_account,omitempty"`
+	DefaultSekPayoutAccount string                     `url:"default_sek_payout_account,omitempty" json:"default_sek_payout_account,omitempty"`
+	DefaultUsdPayoutAccount string                     `url:"default_usd_payout_account,omitempty" json:"default_usd_payout_account,omitempty"`
+	Extra                   map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type CreditorSchemeIdentifiers struct {
-	AddressLine1               string `url:"address_line1,omitempty" json:"address_line1,omitempty"`
-	AddressLine2               string `url:"address_line2,omitempty" json:"address_line2,omitempty"`
-	AddressLine3               string `url:"address_line3,omitempty" json:"address_line3,omitempty"`
-	CanSpecifyMandateReference bool   `url:"can_specify_mandate_reference,omitempty" json:"can_specify_mandate_reference,omitempty"`
-	City                       string `url:"city,omitempty" json:"city,omitempty"`
-	CountryCode                string `url:"country_code,omitempty" json:"country_code,omitempty"`
-	Currency                   string `url:"currency,omitempty" json:"currency,omitempty"`
-	Email                      string `url:"email,omitempty" json:"email,omitempty"`
-	MinimumAdvanceNotice       int    `url:"minimum_advance_notice,omitempty" json:"minimum_advance_notice,omitempty"`
-	Name                       string `url:"name,omitempty" json:"name,omitempty"`
-	PhoneNumber                string `url:"phone_number,omitempty" json:"phone_number,omitempty"`
-	PostalCode                 string `url:"postal_code,omitempty" json:"postal_code,omitempty"`
-	Reference                  string `url:"reference,omitempty" json:"reference,omitempty"`
-	Region                     string `url:"region,omitempty" json:"region,omitempty"`
-	Scheme                     string `url:"scheme,omitempty" json:"scheme,omitempty"`
+	AddressLine1               string                     `url:"address_line1,omitempty" json:"address_line1,omitempty"`
+	AddressLine2               string                     `url:"address_line2,omitempty" json:"address_line2,omitempty"`
+	AddressLine3               string                     `url:"address_line3,omitempty" json:"address_line3,omitempty"`
+	CanSpecifyMandateReference bool                       `url:"can_specify_mandate_reference,omitempty" json:"can_specify_mandate_reference,omitempty"`
+	City                       string                     `url:"city,omitempty" json:"city,omitempty"`
+	CountryCode                string                     `url:"country_code,omitempty" json:"country_code,omitempty"`
+	Currency                   string                     `url:"currency,omitempty" json:"currency,omitempty"`
+	Email                      string                     `url:"email,omitempty" json:"email,omitempty"`
+	MinimumAdvanceNotice       int                        `url:"minimum_advance_notice,omitempty" json:"minimum_advance_notice,omitempty"`
+	Name                       string                     `url:"name,omitempty" json:"name,omitempty"`
+	PhoneNumber                string                     `url:"phone_number,omitempty" json:"phone_number,omitempty"`
+	PostalCode                 string                     `url:"postal_code,omitempty" json:"postal_code,omitempty"`
+	Reference                  string                     `url:"reference,omitempty" json:"reference,omitempty"`
+	Region                     string                     `url:"region,omitempty" json:"region,omitempty"`
+	Scheme                     string                     `url:"scheme,omitempty" json:"scheme,omitempty"`
+	Extra                      map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 // Creditor model
@@ -61,7 +63,7 @@ type Creditor struct {
 	CanCreateRefunds                    bool                        `url:"can_create_refunds,omitempty" json:"can_create_refunds,omitempty"`
 	City                                string                      `url:"city,omitempty" json:"city,omitempty"`
 	CountryCode                         string                      `url:"country_code,omitempty" json:"country_code,omitempty"`
-	CreatedAt                           string                      `url:"created_at,omitempty" json:"created_at,omitempty"`
+	CreatedAt                           *Timestamp                  `url:"created_at,omitempty" json:"created_at,omitempty"`
 	CreditorType                        string                      `url:"creditor_type,omitempty" json:"creditor_type,omitempty"`
 	CustomPaymentPagesEnabled           bool                        `url:"custom_payment_pages_enabled,omitempty" json:"custom_payment_pages_enabled,omitempty"`
 	FxPayoutCurrency                    string                      `url:"fx_payout_currency,omitempty" json:"fx_payout_currency,omitempty"`
@@ -75,6 +77,8 @@ type Creditor struct {
 	Region                              string                      `url:"region,omitempty" json:"region,omitempty"`
 	SchemeIdentifiers                   []CreditorSchemeIdentifiers `url:"scheme_identifiers,omitempty" json:"scheme_identifiers,omitempty"`
 	VerificationStatus                  string                      `url:"verification_status,omitempty" json:"verification_status,omitempty"`
+	Extra                               map[string]json.RawMessage  `url:"-" json:"-"`
+	raw                                 json.RawMessage
 }
 
 type CreditorService interface {
@@ -102,6 +106,10 @@ type CreditorCreateParams struct {
 // Create
 // Creates a new creditor.
 func (s *CreditorServiceImpl) Create(ctx context.Context, p CreditorCreateParams, opts ...RequestOption) (*Creditor, error) {
+	if err := validateParams("creditors", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/creditors"))
 	if err != nil {
 		return nil, err
@@ -192,12 +200,7 @@ func (s *CreditorServiceImpl) Create(ctx context.Context, p CreditorCreateParams
 	return result.Creditor, nil
 }
 
-type CreditorListParamsCreatedAt struct {
-	Gt  string `url:"gt,omitempty" json:"gt,omitempty"`
-	Gte string `url:"gte,omitempty" json:"gte,omitempty"`
-	Lt  string `url:"lt,omitempty" json:"lt,omitempty"`
-	Lte string `url:"lte,omitempty" json:"lte,omitempty"`
-}
+type CreditorListParamsCreatedAt = TimestampRange
 
 // CreditorListParams parameters
 type CreditorListParams struct {
@@ -514,33 +517,37 @@ func (s *CreditorServiceImpl) Get(ctx context.Context, identity string, p Credit
 }
 
 type CreditorUpdateParamsLinks struct {
-	DefaultAudPayoutAccount string `url:"default_aud_payout_account,omitempty" json:"default_aud_payout_account,omitempty"`
-	DefaultCadPayoutAccount string `url:"default_cad_payout_account,omitempty" json:"default_cad_payout_account,omitempty"`
-	DefaultDkkPayoutAccount string `url:"default_dkk_payout_account,omitempty" json:"default_dkk_payout_account,omitempty"`
-	DefaultEurPayoutAccount string `url:"default_eur_payout_account,omitempty" json:"default_eur_payout_account,omitempty"`
-	DefaultGbpPayoutAccount string `url:"default_gbp_payout_account,omitempty" json:"default_gbp_payout_account,omitempty"`
-	DefaultNzdPayoutAccount string `url:"default_nzd_payout_account,omitempty" json:"default_nzd_payout_account,omitempty"`
-	DefaultSekPayoutAccount string `url:"default_sek_payout_account,omitempty" json:"default_sek_payout_account,omitempty"`
-	DefaultUsdPayoutAccount string `url:"default_usd_payout_account,omitempty" json:"default_usd_payout_account,omitempty"`
+	DefaultAudPayoutAccount *OptionalString `url:"default_aud_payout_account,omitempty" json:"default_aud_payout_account,omitempty"`
+	DefaultCadPayoutAccount *OptionalString `url:"default_cad_payout_account,omitempty" json:"default_cad_payout_account,omitempty"`
+	DefaultDkkPayoutAccount *OptionalString `url:"default_dkk_payout_account,omitempty" json:"default_dkk_payout_account,omitempty"`
+	DefaultEurPayoutAccount *OptionalString `url:"default_eur_payout_account,omitempty" json:"default_eur_payout_account,omitempty"`
+	DefaultGbpPayoutAccount *OptionalString `url:"default_gbp_payout_account,omitempty" json:"default_gbp_payout_account,omitempty"`
+	DefaultNzdPayoutAccount *OptionalString `url:"default_nzd_payout_account,omitempty" json:"default_nzd_payout_account,omitempty"`
+	DefaultSekPayoutAccount *OptionalString `url:"default_sek_payout_account,omitempty" json:"default_sek_payout_account,omitempty"`
+	DefaultUsdPayoutAccount *OptionalString `url:"default_usd_payout_account,omitempty" json:"default_usd_payout_account,omitempty"`
 }
 
 // CreditorUpdateParams parameters
 type CreditorUpdateParams struct {
-	AddressLine1 string                     `url:"address_line1,omitempty" json:"address_line1,omitempty"`
-	AddressLine2 string                     `url:"address_line2,omitempty" json:"address_line2,omitempty"`
-	AddressLine3 string                     `url:"address_line3,omitempty" json:"address_line3,omitempty"`
-	City         string                     `url:"city,omitempty" json:"city,omitempty"`
-	CountryCode  string                     `url:"country_code,omitempty" json:"country_code,omitempty"`
+	AddressLine1 *OptionalString            `url:"address_line1,omitempty" json:"address_line1,omitempty"`
+	AddressLine2 *OptionalString            `url:"address_line2,omitempty" json:"address_line2,omitempty"`
+	AddressLine3 *OptionalString            `url:"address_line3,omitempty" json:"address_line3,omitempty"`
+	City         *OptionalString            `url:"city,omitempty" json:"city,omitempty"`
+	CountryCode  *OptionalString            `url:"country_code,omitempty" json:"country_code,omitempty"`
 	Links        *CreditorUpdateParamsLinks `url:"links,omitempty" json:"links,omitempty"`
-	Name         string                     `url:"name,omitempty" json:"name,omitempty"`
-	PostalCode   string                     `url:"postal_code,omitempty" json:"postal_code,omitempty"`
-	Region       string                     `url:"region,omitempty" json:"region,omitempty"`
+	Name         *OptionalString            `url:"name,omitempty" json:"name,omitempty"`
+	PostalCode   *OptionalString            `url:"postal_code,omitempty" json:"postal_code,omitempty"`
+	Region       *OptionalString            `url:"region,omitempty" json:"region,omitempty"`
 }
 
 // Update
 // Updates a creditor object. Supports all of the fields supported when creating
 // a creditor.
 func (s *CreditorServiceImpl) Update(ctx context.Context, identity string, p CreditorUpdateParams, opts ...RequestOption) (*Creditor, error) {
+	if err := validateParams("creditors", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/creditors/%v",
 		identity))
 	if err != nil {
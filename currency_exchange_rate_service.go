@@ -25,10 +25,12 @@ type CurrencyExchangeRateServiceImpl struct {
 
 // CurrencyExchangeRate model
 type CurrencyExchangeRate struct {
-	Rate   string `url:"rate,omitempty" json:"rate,omitempty"`
-	Source string `url:"source,omitempty" json:"source,omitempty"`
-	Target string `url:"target,omitempty" json:"target,omitempty"`
-	Time   string `url:"time,omitempty" json:"time,omitempty"`
+	Rate   string                     `url:"rate,omitempty" json:"rate,omitempty"`
+	Source string                     `url:"source,omitempty" json:"source,omitempty"`
+	Target string                     `url:"target,omitempty" json:"target,omitempty"`
+	Time   *Timestamp                 `url:"time,omitempty" json:"time,omitempty"`
+	Extra  map[string]json.RawMessage `url:"-" json:"-"`
+	raw    json.RawMessage
 }
 
 type CurrencyExchangeRateService interface {
@@ -36,12 +38,7 @@ type CurrencyExchangeRateService interface {
 	All(ctx context.Context, p CurrencyExchangeRateListParams, opts ...RequestOption) *CurrencyExchangeRateListPagingIterator
 }
 
-type CurrencyExchangeRateListParamsCreatedAt struct {
-	Gt  string `url:"gt,omitempty" json:"gt,omitempty"`
-	Gte string `url:"gte,omitempty" json:"gte,omitempty"`
-	Lt  string `url:"lt,omitempty" json:"lt,omitempty"`
-	Lte string `url:"lte,omitempty" json:"lte,omitempty"`
-}
+type CurrencyExchangeRateListParamsCreatedAt = TimestampRange
 
 // CurrencyExchangeRateListParams parameters
 type CurrencyExchangeRateListParams struct {
@@ -24,22 +24,25 @@ type CustomerBankAccountServiceImpl struct {
 }
 
 type CustomerBankAccountLinks struct {
-	Customer string `url:"customer,omitempty" json:"customer,omitempty"`
+	Customer string                     `url:"customer,omitempty" json:"customer,omitempty"`
+	Extra    map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 // CustomerBankAccount model
 type CustomerBankAccount struct {
-	AccountHolderName   string                    `url:"account_holder_name,omitempty" json:"account_holder_name,omitempty"`
-	AccountNumberEnding string                    `url:"account_number_ending,omitempty" json:"account_number_ending,omitempty"`
-	AccountType         string                    `url:"account_type,omitempty" json:"account_type,omitempty"`
-	BankName            string                    `url:"bank_name,omitempty" json:"bank_name,omitempty"`
-	CountryCode         string                    `url:"country_code,omitempty" json:"country_code,omitempty"`
-	CreatedAt           string                    `url:"created_at,omitempty" json:"created_at,omitempty"`
-	Currency            string                    `url:"currency,omitempty" json:"currency,omitempty"`
-	Enabled             bool                      `url:"enabled,omitempty" json:"enabled,omitempty"`
-	Id                  string                    `url:"id,omitempty" json:"id,omitempty"`
-	Links               *CustomerBankAccountLinks `url:"links,omitempty" json:"links,omitempty"`
-	Metadata            map[string]interface{}    `url:"metadata,omitempty" json:"metadata,omitempty"`
+	AccountHolderName   string                     `url:"account_holder_name,omitempty" json:"account_holder_name,omitempty"`
+	AccountNumberEnding string                     `url:"account_number_ending,omitempty" json:"account_number_ending,omitempty"`
+	AccountType         string                     `url:"account_type,omitempty" json:"account_type,omitempty"`
+	BankName            string                     `url:"bank_name,omitempty" json:"bank_name,omitempty"`
+	CountryCode         string                     `url:"country_code,omitempty" json:"country_code,omitempty"`
+	CreatedAt           *Timestamp                 `url:"created_at,omitempty" json:"created_at,omitempty"`
+	Currency            string                     `url:"currency,omitempty" json:"currency,omitempty"`
+	Enabled             bool                       `url:"enabled,omitempty" json:"enabled,omitempty"`
+	Id                  string                     `url:"id,omitempty" json:"id,omitempty"`
+	Links               *CustomerBankAccountLinks  `url:"links,omitempty" json:"links,omitempty"`
+	Metadata            Metadata                   `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Extra               map[string]json.RawMessage `url:"-" json:"-"`
+	raw                 json.RawMessage
 }
 
 type CustomerBankAccountService interface {
@@ -67,7 +70,7 @@ type CustomerBankAccountCreateParams struct {
 	Currency          string                               `url:"currency,omitempty" json:"currency,omitempty"`
 	Iban              string                               `url:"iban,omitempty" json:"iban,omitempty"`
 	Links             CustomerBankAccountCreateParamsLinks `url:"links,omitempty" json:"links,omitempty"`
-	Metadata          map[string]interface{}               `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata          Metadata                             `url:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 // Create
@@ -85,6 +88,10 @@ type CustomerBankAccountCreateParams struct {
 // For more information on the different fields required in each country, see
 // [local bank details](#appendix-local-bank-details).
 func (s *CustomerBankAccountServiceImpl) Create(ctx context.Context, p CustomerBankAccountCreateParams, opts ...RequestOption) (*CustomerBankAccount, error) {
+	if err := validateParams("customer_bank_accounts", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/customer_bank_accounts"))
 	if err != nil {
 		return nil, err
@@ -175,12 +182,7 @@ func (s *CustomerBankAccountServiceImpl) Create(ctx context.Context, p CustomerB
 	return result.CustomerBankAccount, nil
 }
 
-type CustomerBankAccountListParamsCreatedAt struct {
-	Gt  string `url:"gt,omitempty" json:"gt,omitempty"`
-	Gte string `url:"gte,omitempty" json:"gte,omitempty"`
-	Lt  string `url:"lt,omitempty" json:"lt,omitempty"`
-	Lte string `url:"lte,omitempty" json:"lte,omitempty"`
-}
+type CustomerBankAccountListParamsCreatedAt = TimestampRange
 
 // CustomerBankAccountListParams parameters
 type CustomerBankAccountListParams struct {
@@ -496,13 +498,17 @@ func (s *CustomerBankAccountServiceImpl) Get(ctx context.Context, identity strin
 
 // CustomerBankAccountUpdateParams parameters
 type CustomerBankAccountUpdateParams struct {
-	Metadata map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 // Update
 // Updates a customer bank account object. Only the metadata parameter is
 // allowed.
 func (s *CustomerBankAccountServiceImpl) Update(ctx context.Context, identity string, p CustomerBankAccountUpdateParams, opts ...RequestOption) (*CustomerBankAccount, error) {
+	if err := validateParams("customer_bank_accounts", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/customer_bank_accounts/%v",
 		identity))
 	if err != nil {
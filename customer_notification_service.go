@@ -24,22 +24,25 @@ type CustomerNotificationServiceImpl struct {
 }
 
 type CustomerNotificationLinks struct {
-	Customer     string `url:"customer,omitempty" json:"customer,omitempty"`
-	Event        string `url:"event,omitempty" json:"event,omitempty"`
-	Mandate      string `url:"mandate,omitempty" json:"mandate,omitempty"`
-	Payment      string `url:"payment,omitempty" json:"payment,omitempty"`
-	Refund       string `url:"refund,omitempty" json:"refund,omitempty"`
-	Subscription string `url:"subscription,omitempty" json:"subscription,omitempty"`
+	Customer     string                     `url:"customer,omitempty" json:"customer,omitempty"`
+	Event        string                     `url:"event,omitempty" json:"event,omitempty"`
+	Mandate      string                     `url:"mandate,omitempty" json:"mandate,omitempty"`
+	Payment      string                     `url:"payment,omitempty" json:"payment,omitempty"`
+	Refund       string                     `url:"refund,omitempty" json:"refund,omitempty"`
+	Subscription string                     `url:"subscription,omitempty" json:"subscription,omitempty"`
+	Extra        map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 // CustomerNotification model
 type CustomerNotification struct {
 	ActionTaken   string                     `url:"action_taken,omitempty" json:"action_taken,omitempty"`
-	ActionTakenAt string                     `url:"action_taken_at,omitempty" json:"action_taken_at,omitempty"`
+	ActionTakenAt *Timestamp                 `url:"action_taken_at,omitempty" json:"action_taken_at,omitempty"`
 	ActionTakenBy string                     `url:"action_taken_by,omitempty" json:"action_taken_by,omitempty"`
 	Id            string                     `url:"id,omitempty" json:"id,omitempty"`
 	Links         *CustomerNotificationLinks `url:"links,omitempty" json:"links,omitempty"`
 	Type          string                     `url:"type,omitempty" json:"type,omitempty"`
+	Extra         map[string]json.RawMessage `url:"-" json:"-"`
+	raw           json.RawMessage
 }
 
 type CustomerNotificationService interface {
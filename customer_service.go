@@ -25,24 +25,26 @@ type CustomerServiceImpl struct {
 
 // Customer model
 type Customer struct {
-	AddressLine1          string                 `url:"address_line1,omitempty" json:"address_line1,omitempty"`
-	AddressLine2          string                 `url:"address_line2,omitempty" json:"address_line2,omitempty"`
-	AddressLine3          string                 `url:"address_line3,omitempty" json:"address_line3,omitempty"`
-	City                  string                 `url:"city,omitempty" json:"city,omitempty"`
-	CompanyName           string                 `url:"company_name,omitempty" json:"company_name,omitempty"`
-	CountryCode           string                 `url:"country_code,omitempty" json:"country_code,omitempty"`
-	CreatedAt             string                 `url:"created_at,omitempty" json:"created_at,omitempty"`
-	DanishIdentityNumber  string                 `url:"danish_identity_number,omitempty" json:"danish_identity_number,omitempty"`
-	Email                 string                 `url:"email,omitempty" json:"email,omitempty"`
-	FamilyName            string                 `url:"family_name,omitempty" json:"family_name,omitempty"`
-	GivenName             string                 `url:"given_name,omitempty" json:"given_name,omitempty"`
-	Id                    string                 `url:"id,omitempty" json:"id,omitempty"`
-	Language              string                 `url:"language,omitempty" json:"language,omitempty"`
-	Metadata              map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	PhoneNumber           string                 `url:"phone_number,omitempty" json:"phone_number,omitempty"`
-	PostalCode            string                 `url:"postal_code,omitempty" json:"postal_code,omitempty"`
-	Region                string                 `url:"region,omitempty" json:"region,omitempty"`
-	SwedishIdentityNumber string                 `url:"swedish_identity_number,omitempty" json:"swedish_identity_number,omitempty"`
+	AddressLine1          string                     `url:"address_line1,omitempty" json:"address_line1,omitempty"`
+	AddressLine2          string                     `url:"address_line2,omitempty" json:"address_line2,omitempty"`
+	AddressLine3          string                     `url:"address_line3,omitempty" json:"address_line3,omitempty"`
+	City                  string                     `url:"city,omitempty" json:"city,omitempty"`
+	CompanyName           string                     `url:"company_name,omitempty" json:"company_name,omitempty"`
+	CountryCode           string                     `url:"country_code,omitempty" json:"country_code,omitempty"`
+	CreatedAt             *Timestamp                 `url:"created_at,omitempty" json:"created_at,omitempty"`
+	DanishIdentityNumber  string                     `url:"danish_identity_number,omitempty" json:"danish_identity_number,omitempty"`
+	Email                 string                     `url:"email,omitempty" json:"email,omitempty"`
+	FamilyName            string                     `url:"family_name,omitempty" json:"family_name,omitempty"`
+	GivenName             string                     `url:"given_name,omitempty" json:"given_name,omitempty"`
+	Id                    string                     `url:"id,omitempty" json:"id,omitempty"`
+	Language              string                     `url:"language,omitempty" json:"language,omitempty"`
+	Metadata              Metadata                   `url:"metadata,omitempty" json:"metadata,omitempty"`
+	PhoneNumber           string                     `url:"phone_number,omitempty" json:"phone_number,omitempty"`
+	PostalCode            string                     `url:"postal_code,omitempty" json:"postal_code,omitempty"`
+	Region                string                     `url:"region,omitempty" json:"region,omitempty"`
+	SwedishIdentityNumber string                     `url:"swedish_identity_number,omitempty" json:"swedish_identity_number,omitempty"`
+	Extra                 map[string]json.RawMessage `url:"-" json:"-"`
+	raw                   json.RawMessage
 }
 
 type CustomerService interface {
@@ -56,27 +58,31 @@ type CustomerService interface {
 
 // CustomerCreateParams parameters
 type CustomerCreateParams struct {
-	AddressLine1          string                 `url:"address_line1,omitempty" json:"address_line1,omitempty"`
-	AddressLine2          string                 `url:"address_line2,omitempty" json:"address_line2,omitempty"`
-	AddressLine3          string                 `url:"address_line3,omitempty" json:"address_line3,omitempty"`
-	City                  string                 `url:"city,omitempty" json:"city,omitempty"`
-	CompanyName           string                 `url:"company_name,omitempty" json:"company_name,omitempty"`
-	CountryCode           string                 `url:"country_code,omitempty" json:"country_code,omitempty"`
-	DanishIdentityNumber  string                 `url:"danish_identity_number,omitempty" json:"danish_identity_number,omitempty"`
-	Email                 string                 `url:"email,omitempty" json:"email,omitempty"`
-	FamilyName            string                 `url:"family_name,omitempty" json:"family_name,omitempty"`
-	GivenName             string                 `url:"given_name,omitempty" json:"given_name,omitempty"`
-	Language              string                 `url:"language,omitempty" json:"language,omitempty"`
-	Metadata              map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	PhoneNumber           string                 `url:"phone_number,omitempty" json:"phone_number,omitempty"`
-	PostalCode            string                 `url:"postal_code,omitempty" json:"postal_code,omitempty"`
-	Region                string                 `url:"region,omitempty" json:"region,omitempty"`
-	SwedishIdentityNumber string                 `url:"swedish_identity_number,omitempty" json:"swedish_identity_number,omitempty"`
+	AddressLine1          string   `url:"address_line1,omitempty" json:"address_line1,omitempty"`
+	AddressLine2          string   `url:"address_line2,omitempty" json:"address_line2,omitempty"`
+	AddressLine3          string   `url:"address_line3,omitempty" json:"address_line3,omitempty"`
+	City                  string   `url:"city,omitempty" json:"city,omitempty"`
+	CompanyName           string   `url:"company_name,omitempty" json:"company_name,omitempty"`
+	CountryCode           string   `url:"country_code,omitempty" json:"country_code,omitempty"`
+	DanishIdentityNumber  string   `url:"danish_identity_number,omitempty" json:"danish_identity_number,omitempty"`
+	Email                 string   `url:"email,omitempty" json:"email,omitempty"`
+	FamilyName            string   `url:"family_name,omitempty" json:"family_name,omitempty"`
+	GivenName             string   `url:"given_name,omitempty" json:"given_name,omitempty"`
+	Language              string   `url:"language,omitempty" json:"language,omitempty"`
+	Metadata              Metadata `url:"metadata,omitempty" json:"metadata,omitempty"`
+	PhoneNumber           string   `url:"phone_number,omitempty" json:"phone_number,omitempty"`
+	PostalCode            string   `url:"postal_code,omitempty" json:"postal_code,omitempty"`
+	Region                string   `url:"region,omitempty" json:"region,omitempty"`
+	SwedishIdentityNumber string   `url:"swedish_identity_number,omitempty" json:"swedish_identity_number,omitempty"`
 }
 
 // Create
 // Creates a new customer object.
 func (s *CustomerServiceImpl) Create(ctx context.Context, p CustomerCreateParams, opts ...RequestOption) (*Customer, error) {
+	if err := validateParams("customers", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/customers"))
 	if err != nil {
 		return nil, err
@@ -167,12 +173,7 @@ func (s *CustomerServiceImpl) Create(ctx context.Context, p CustomerCreateParams
 	return result.Customer, nil
 }
 
-type CustomerListParamsCreatedAt struct {
-	Gt  string `url:"gt,omitempty" json:"gt,omitempty"`
-	Gte string `url:"gte,omitempty" json:"gte,omitempty"`
-	Lt  string `url:"lt,omitempty" json:"lt,omitempty"`
-	Lte string `url:"lte,omitempty" json:"lte,omitempty"`
-}
+type CustomerListParamsCreatedAt = TimestampRange
 
 // CustomerListParams parameters
 type CustomerListParams struct {
@@ -489,28 +490,32 @@ func (s *CustomerServiceImpl) Get(ctx context.Context, identity string, opts ...
 
 // CustomerUpdateParams parameters
 type CustomerUpdateParams struct {
-	AddressLine1          string                 `url:"address_line1,omitempty" json:"address_line1,omitempty"`
-	AddressLine2          string                 `url:"address_line2,omitempty" json:"address_line2,omitempty"`
-	AddressLine3          string                 `url:"address_line3,omitempty" json:"address_line3,omitempty"`
-	City                  string                 `url:"city,omitempty" json:"city,omitempty"`
-	CompanyName           string                 `url:"company_name,omitempty" json:"company_name,omitempty"`
-	CountryCode           string                 `url:"country_code,omitempty" json:"country_code,omitempty"`
-	DanishIdentityNumber  string                 `url:"danish_identity_number,omitempty" json:"danish_identity_number,omitempty"`
-	Email                 string                 `url:"email,omitempty" json:"email,omitempty"`
-	FamilyName            string                 `url:"family_name,omitempty" json:"family_name,omitempty"`
-	GivenName             string                 `url:"given_name,omitempty" json:"given_name,omitempty"`
-	Language              string                 `url:"language,omitempty" json:"language,omitempty"`
-	Metadata              map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	PhoneNumber           string                 `url:"phone_number,omitempty" json:"phone_number,omitempty"`
-	PostalCode            string                 `url:"postal_code,omitempty" json:"postal_code,omitempty"`
-	Region                string                 `url:"region,omitempty" json:"region,omitempty"`
-	SwedishIdentityNumber string                 `url:"swedish_identity_number,omitempty" json:"swedish_identity_number,omitempty"`
+	AddressLine1          *OptionalString   `url:"address_line1,omitempty" json:"address_line1,omitempty"`
+	AddressLine2          *OptionalString   `url:"address_line2,omitempty" json:"address_line2,omitempty"`
+	AddressLine3          *OptionalString   `url:"address_line3,omitempty" json:"address_line3,omitempty"`
+	City                  *OptionalString   `url:"city,omitempty" json:"city,omitempty"`
+	CompanyName           *OptionalString   `url:"company_name,omitempty" json:"company_name,omitempty"`
+	CountryCode           *OptionalString   `url:"country_code,omitempty" json:"country_code,omitempty"`
+	DanishIdentityNumber  *OptionalString   `url:"danish_identity_number,omitempty" json:"danish_identity_number,omitempty"`
+	Email                 *OptionalString   `url:"email,omitempty" json:"email,omitempty"`
+	FamilyName            *OptionalString   `url:"family_name,omitempty" json:"family_name,omitempty"`
+	GivenName             *OptionalString   `url:"given_name,omitempty" json:"given_name,omitempty"`
+	Language              *OptionalString   `url:"language,omitempty" json:"language,omitempty"`
+	Metadata              *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
+	PhoneNumber           *OptionalString   `url:"phone_number,omitempty" json:"phone_number,omitempty"`
+	PostalCode            *OptionalString   `url:"postal_code,omitempty" json:"postal_code,omitempty"`
+	Region                *OptionalString   `url:"region,omitempty" json:"region,omitempty"`
+	SwedishIdentityNumber *OptionalString   `url:"swedish_identity_number,omitempty" json:"swedish_identity_number,omitempty"`
 }
 
 // Update
 // Updates a customer object. Supports all of the fields supported when creating
 // a customer.
 func (s *CustomerServiceImpl) Update(ctx context.Context, identity string, p CustomerUpdateParams, opts ...RequestOption) (*Customer, error) {
+	if err := validateParams("customers", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/customers/%v",
 		identity))
 	if err != nil {
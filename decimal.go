@@ -0,0 +1,311 @@
+package gocardless
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// RoundingMode is the rule used to round decimals.
+type RoundingMode int
+
+const (
+	// RoundHalfEven rounds to the nearest value, ties going to the even
+	// neighbour. It is also known as banker's rounding.
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to the nearest value, ties going away from zero.
+	RoundHalfUp
+)
+
+// ErrDivisionByZero is returned when dividing a Decimal by zero.
+var ErrDivisionByZero = errors.New("division by zero")
+
+// Decimal is an exact decimal number, such as an exchange rate or a tax
+// percentage. It keeps the number of decimal places it was parsed with. The
+// zero value is 0.
+type Decimal struct {
+	unscaled *big.Int
+	scale    int
+}
+
+// NewDecimal returns the Decimal unscaled * 10^-scale, e.g. NewDecimal(125, 2)
+// is 1.25.
+func NewDecimal(unscaled int64, scale int) Decimal {
+	return newDecimal(big.NewInt(unscaled), scale)
+}
+
+// newDecimal returns unscaled * 10^-scale, with a negative scale turned
+// into trailing zeros.
+func newDecimal(unscaled *big.Int, scale int) Decimal {
+	if scale < 0 {
+		return Decimal{unscaled: unscaled.Mul(unscaled, pow10(-scale))}
+	}
+	return Decimal{unscaled: unscaled, scale: scale}
+}
+
+// ParseDecimal parses a decimal number such as "1.1234567890" or "-20".
+// Only '.' is accepted as decimal separator, without digit grouping or
+// exponent.
+func ParseDecimal(s string) (Decimal, error) {
+	digits := s
+	if digits != "" && (digits[0] == '-' || digits[0] == '+') {
+		digits = digits[1:]
+	}
+	whole, frac := digits, ""
+	if i := strings.IndexByte(digits, '.'); i >= 0 {
+		whole, frac = digits[:i], digits[i+1:]
+		if frac == "" {
+			return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+		}
+	}
+	if whole == "" || !isDigits(whole) || !isDigits(frac) {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	unscaled, ok := new(big.Int).SetString(whole+frac, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	if s[0] == '-' {
+		unscaled.Neg(unscaled)
+	}
+	return Decimal{unscaled: unscaled, scale: len(frac)}, nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func (d Decimal) int() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+// Scale returns the number of decimal places of d.
+func (d Decimal) Scale() int {
+	return d.scale
+}
+
+// String returns d with all its decimal places, e.g. "1.1234567890".
+func (d Decimal) String() string {
+	s := new(big.Int).Abs(d.int()).String()
+	if d.scale > 0 {
+		if len(s) <= d.scale {
+			s = strings.Repeat("0", d.scale-len(s)+1) + s
+		}
+		s = s[:len(s)-d.scale] + "." + s[len(s)-d.scale:]
+	}
+	if d.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Decimal) UnmarshalText(data []byte) error {
+	v, err := ParseDecimal(string(data))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d.
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// IsZero reports whether d is zero.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp compares d and o, returning -1, 0 or +1.
+func (d Decimal) Cmp(o Decimal) int {
+	a, b := align(d, o)
+	return a.Cmp(b)
+}
+
+// align returns the unscaled values of d and o at their largest scale.
+func align(d, o Decimal) (*big.Int, *big.Int) {
+	a, b := d.int(), o.int()
+	switch {
+	case d.scale < o.scale:
+		a = new(big.Int).Mul(a, pow10(o.scale-d.scale))
+	case d.scale > o.scale:
+		b = new(big.Int).Mul(b, pow10(d.scale-o.scale))
+	}
+	return a, b
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// Add returns d + o, exactly.
+func (d Decimal) Add(o Decimal) Decimal {
+	a, b := align(d, o)
+	return Decimal{unscaled: new(big.Int).Add(a, b), scale: maxInt(d.scale, o.scale)}
+}
+
+// Sub returns d - o, exactly.
+func (d Decimal) Sub(o Decimal) Decimal {
+	return d.Add(o.Neg())
+}
+
+// Mul returns d * o, exactly.
+func (d Decimal) Mul(o Decimal) Decimal {
+	return Decimal{unscaled: new(big.Int).Mul(d.int(), o.int()), scale: d.scale + o.scale}
+}
+
+// Div returns d / o rounded to scale decimal places. A negative scale
+// rounds to a multiple of a power of ten, e.g. -1 to tens.
+func (d Decimal) Div(o Decimal, scale int, mode RoundingMode) (Decimal, error) {
+	if o.IsZero() {
+		return Decimal{}, ErrDivisionByZero
+	}
+	// d / o at the given scale is (d.unscaled * 10^(scale + o.scale -
+	// d.scale)) / o.unscaled.
+	num, den := new(big.Int).Set(d.int()), new(big.Int).Set(o.int())
+	if shift := scale + o.scale - d.scale; shift >= 0 {
+		num.Mul(num, pow10(shift))
+	} else {
+		den.Mul(den, pow10(-shift))
+	}
+	return newDecimal(roundQuo(num, den, mode), scale), nil
+}
+
+// Round returns d rounded to scale decimal places. Decimals with fewer
+// decimal places are padded with zeros. A negative scale rounds to a
+// multiple of a power of ten, e.g. -1 to tens.
+func (d Decimal) Round(scale int, mode RoundingMode) Decimal {
+	if d.scale <= scale {
+		return Decimal{unscaled: new(big.Int).Mul(d.int(), pow10(scale-d.scale)), scale: scale}
+	}
+	return newDecimal(roundQuo(d.int(), pow10(d.scale-scale), mode), scale)
+}
+
+// roundQuo returns num / den rounded to an integer.
+func roundQuo(num, den *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	// Compare the remainder with half the divisor.
+	half := new(big.Int).Abs(r)
+	half.Lsh(half, 1)
+	c := half.Cmp(new(big.Int).Abs(den))
+	if c > 0 || (c == 0 && (mode == RoundHalfUp || q.Bit(0) == 1)) {
+		if num.Sign()*den.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Decimal returns the amount in the major unit of its currency, e.g. 10.05
+// for 1005 GBP. The currency must be supported.
+func (m Money) Decimal() (Decimal, error) {
+	units, err := m.Currency.MinorUnits()
+	if err != nil {
+		return Decimal{}, err
+	}
+	return NewDecimal(m.Amount, units), nil
+}
+
+// MoneyFromDecimal returns the amount d, given in the major unit of currency,
+// rounded to the minor unit.
+func MoneyFromDecimal(d Decimal, currency Currency, mode RoundingMode) (Money, error) {
+	units, err := currency.MinorUnits()
+	if err != nil {
+		return Money{}, err
+	}
+	amount := d.Round(units, mode).int()
+	if !amount.IsInt64() {
+		return Money{}, ErrMoneyOverflow
+	}
+	return Money{Amount: amount.Int64(), Currency: currency}, nil
+}
+
+// MulDecimal returns m multiplied by d, rounded to the minor unit, e.g. to
+// apply a tax rate.
+func (m Money) MulDecimal(d Decimal, mode RoundingMode) (Money, error) {
+	return m.Convert(d, m.Currency, mode)
+}
+
+// Convert returns m exchanged into currency at rate, the number of units of
+// currency per unit of the currency of m, rounded to the minor unit.
+func (m Money) Convert(rate Decimal, currency Currency, mode RoundingMode) (Money, error) {
+	d, err := m.Decimal()
+	if err != nil {
+		return Money{}, err
+	}
+	return MoneyFromDecimal(d.Mul(rate), currency, mode)
+}
+
+// RateDecimal returns the exchange rate.
+func (r CurrencyExchangeRate) RateDecimal() (Decimal, error) {
+	return ParseDecimal(r.Rate)
+}
+
+// EstimatedExchangeRateDecimal returns the estimated exchange rate.
+func (fx PaymentFx) EstimatedExchangeRateDecimal() (Decimal, error) {
+	return ParseDecimal(fx.EstimatedExchangeRate)
+}
+
+// ExchangeRateDecimal returns the exchange rate, once the payment is paid
+// out.
+func (fx PaymentFx) ExchangeRateDecimal() (Decimal, error) {
+	return ParseDecimal(fx.ExchangeRate)
+}
+
+// EstimatedExchangeRateDecimal returns the estimated exchange rate.
+func (fx PayoutFx) EstimatedExchangeRateDecimal() (Decimal, error) {
+	return ParseDecimal(fx.EstimatedExchangeRate)
+}
+
+// ExchangeRateDecimal returns the exchange rate, once the payout is paid.
+func (fx PayoutFx) ExchangeRateDecimal() (Decimal, error) {
+	return ParseDecimal(fx.ExchangeRate)
+}
+
+// EstimatedExchangeRateDecimal returns the estimated exchange rate.
+func (fx RefundFx) EstimatedExchangeRateDecimal() (Decimal, error) {
+	return ParseDecimal(fx.EstimatedExchangeRate)
+}
+
+// ExchangeRateDecimal returns the exchange rate, once the refund is
+// processed.
+func (fx RefundFx) ExchangeRateDecimal() (Decimal, error) {
+	return ParseDecimal(fx.ExchangeRate)
+}
+
+// ExchangeRateDecimal returns the rate the tax was exchanged at.
+func (t PayoutItemTaxes) ExchangeRateDecimal() (Decimal, error) {
+	return ParseDecimal(t.ExchangeRate)
+}
+
+// PercentageDecimal returns the tax rate as a percentage, e.g. 20.0.
+func (r TaxRate) PercentageDecimal() (Decimal, error) {
+	return ParseDecimal(r.Percentage)
+}
@@ -0,0 +1,138 @@
+package gocardless
+
+import (
+	"testing"
+)
+
+func mustParseDecimal(t *testing.T, s string) Decimal {
+	t.Helper()
+	d, err := ParseDecimal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestParseDecimal(t *testing.T) {
+	for _, s := range []string{"1.1234567890", "-20", "0.05", "20.0", "-0.001", "123456789012345678901234567890.5"} {
+		if d := mustParseDecimal(t, s); d.String() != s {
+			t.Fatalf("Expected %q, got %q", s, d.String())
+		}
+	}
+	if d := mustParseDecimal(t, "+1.5"); d.String() != "1.5" {
+		t.Fatalf("Expected %q, got %q", "1.5", d.String())
+	}
+	for _, s := range []string{"", "-", "1.", ".5", "1,5", "1e3", "1.2.3", "0x10"} {
+		if _, err := ParseDecimal(s); err == nil {
+			t.Fatalf("Expected error parsing %q", s)
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a, b := mustParseDecimal(t, "1.25"), mustParseDecimal(t, "0.1")
+
+	for expected, d := range map[string]Decimal{
+		"1.35":   a.Add(b),
+		"1.15":   a.Sub(b),
+		"-1.15":  b.Sub(a),
+		"0.125":  a.Mul(b),
+		"0.0000": Decimal{}.Round(4, RoundHalfEven),
+		"2.50":   NewDecimal(25, 1).Round(2, RoundHalfEven),
+		"300":    NewDecimal(3, -2),
+	} {
+		if d.String() != expected {
+			t.Fatalf("Expected %q, got %q", expected, d.String())
+		}
+	}
+
+	q, err := a.Div(mustParseDecimal(t, "3"), 4, RoundHalfEven)
+	if err != nil || q.String() != "0.4167" {
+		t.Fatalf("Expected %q, got %q (%v)", "0.4167", q, err)
+	}
+	if _, err := a.Div(Decimal{}, 2, RoundHalfUp); err != ErrDivisionByZero {
+		t.Fatalf("Expected %v, got %v", ErrDivisionByZero, err)
+	}
+	if a.Cmp(mustParseDecimal(t, "1.250")) != 0 || a.Cmp(b) != 1 || b.Cmp(a) != -1 {
+		t.Fatal("Unexpected comparison")
+	}
+}
+
+func TestDecimalRounding(t *testing.T) {
+	for _, c := range []struct {
+		in       string
+		mode     RoundingMode
+		expected string
+	}{
+		{"0.125", RoundHalfEven, "0.12"},
+		{"0.135", RoundHalfEven, "0.14"},
+		{"-0.125", RoundHalfEven, "-0.12"},
+		{"0.125", RoundHalfUp, "0.13"},
+		{"-0.125", RoundHalfUp, "-0.13"},
+		{"0.1251", RoundHalfEven, "0.13"},
+		{"-0.1249", RoundHalfUp, "-0.12"},
+	} {
+		if d := mustParseDecimal(t, c.in).Round(2, c.mode); d.String() != c.expected {
+			t.Fatalf("Expected %q for %q, got %q", c.expected, c.in, d.String())
+		}
+	}
+
+	q, err := NewDecimal(-5, 0).Div(NewDecimal(2, 0), 0, RoundHalfEven)
+	if err != nil || q.String() != "-2" {
+		t.Fatalf("Expected %q, got %q (%v)", "-2", q, err)
+	}
+	q, err = NewDecimal(-5, 0).Div(NewDecimal(2, 0), 0, RoundHalfUp)
+	if err != nil || q.String() != "-3" {
+		t.Fatalf("Expected %q, got %q (%v)", "-3", q, err)
+	}
+}
+
+func TestDecimalNegativeScale(t *testing.T) {
+	if d := NewDecimal(125, 0).Round(-1, RoundHalfUp); d.String() != "130" {
+		t.Fatalf("Expected %q, got %q", "130", d.String())
+	}
+	if d := mustParseDecimal(t, "1249.5").Round(-2, RoundHalfEven); d.String() != "1200" {
+		t.Fatalf("Expected %q, got %q", "1200", d.String())
+	}
+	q, err := NewDecimal(1000, 0).Div(NewDecimal(3, 0), -1, RoundHalfEven)
+	if err != nil || q.String() != "330" {
+		t.Fatalf("Expected %q, got %q (%v)", "330", q, err)
+	}
+}
+
+func TestMoneyConvert(t *testing.T) {
+	rate := mustParseDecimal(t, "1.1234567890")
+
+	m, err := NewMoney(1000, CurrencyGBP).Convert(rate, CurrencyEUR, RoundHalfEven)
+	if err != nil || m != NewMoney(1123, CurrencyEUR) {
+		t.Fatalf("Unexpected conversion %v (%v)", m, err)
+	}
+
+	// 0.25 GBP * 1.1 = 0.275 EUR
+	m, err = NewMoney(25, CurrencyGBP).Convert(mustParseDecimal(t, "1.1"), CurrencyEUR, RoundHalfEven)
+	if err != nil || m != NewMoney(28, CurrencyEUR) {
+		t.Fatalf("Unexpected conversion %v (%v)", m, err)
+	}
+
+	tax, err := NewMoney(4500, CurrencyGBP).MulDecimal(mustParseDecimal(t, "0.2"), RoundHalfUp)
+	if err != nil || tax != NewMoney(900, CurrencyGBP) {
+		t.Fatalf("Unexpected tax %v (%v)", tax, err)
+	}
+
+	if _, err := NewMoney(1000, CurrencyGBP).Convert(rate, "XYZ", RoundHalfEven); err == nil {
+		t.Fatal("Expected error for unsupported currency")
+	}
+}
+
+func TestDecimalAccessors(t *testing.T) {
+	fx := PaymentFx{ExchangeRate: "1.1234567890", FxAmount: 1123, FxCurrency: "EUR"}
+	rate, err := fx.ExchangeRateDecimal()
+	if err != nil || rate.String() != "1.1234567890" {
+		t.Fatalf("Unexpected rate %v (%v)", rate, err)
+	}
+
+	pct, err := TaxRate{Percentage: "20.0"}.PercentageDecimal()
+	if err != nil || pct.Cmp(NewDecimal(20, 0)) != 0 {
+		t.Fatalf("Unexpected percentage %v (%v)", pct, err)
+	}
+}
@@ -0,0 +1,357 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+)
+
+// EnrichedEvent holds an event together with the resources it refers to.
+// Only the field matching the event resource type is set, along with
+// ParentEvent and NewMandate when requested and linked.
+type EnrichedEvent struct {
+	Event
+
+	BillingRequest     *BillingRequest
+	Creditor           *Creditor
+	Customer           *Customer
+	InstalmentSchedule *InstalmentSchedule
+	Mandate            *Mandate
+	PayerAuthorisation *PayerAuthorisation
+	Payment            *Payment
+	Payout             *Payout
+	Refund             *Refund
+	Subscription       *Subscription
+
+	ParentEvent *Event
+	NewMandate  *Mandate
+}
+
+// enrichedEvent is the JSON encoding of an EnrichedEvent, which holds the
+// event under its own key so that it keeps the JSON it was decoded from.
+type enrichedEvent struct {
+	Event              *Event              `json:"event"`
+	BillingRequest     *BillingRequest     `json:"billing_request,omitempty"`
+	Creditor           *Creditor           `json:"creditor,omitempty"`
+	Customer           *Customer           `json:"customer,omitempty"`
+	InstalmentSchedule *InstalmentSchedule `json:"instalment_schedule,omitempty"`
+	Mandate            *Mandate            `json:"mandate,omitempty"`
+	PayerAuthorisation *PayerAuthorisation `json:"payer_authorisation,omitempty"`
+	Payment            *Payment            `json:"payment,omitempty"`
+	Payout             *Payout             `json:"payout,omitempty"`
+	Refund             *Refund             `json:"refund,omitempty"`
+	Subscription       *Subscription       `json:"subscription,omitempty"`
+	ParentEvent        *Event              `json:"parent_event,omitempty"`
+	NewMandate         *Mandate            `json:"new_mandate,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, encoding the event under "event"
+// and the resources under their resource names. Without it, the marshaller
+// of the embedded Event would be promoted and encode the event alone.
+func (e EnrichedEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enrichedEvent{
+		&e.Event, e.BillingRequest, e.Creditor, e.Customer, e.InstalmentSchedule, e.Mandate,
+		e.PayerAuthorisation, e.Payment, e.Payout, e.Refund, e.Subscription,
+		e.ParentEvent, e.NewMandate,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding the encoding of
+// MarshalJSON.
+func (e *EnrichedEvent) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var r enrichedEvent
+	if err := json.Unmarshal(data, &r); err != nil {
+		return err
+	}
+	if r.Event != nil {
+		e.Event = *r.Event
+	}
+	e.BillingRequest, e.Creditor, e.Customer, e.InstalmentSchedule, e.Mandate = r.BillingRequest, r.Creditor, r.Customer, r.InstalmentSchedule, r.Mandate
+	e.PayerAuthorisation, e.Payment, e.Payout, e.Refund, e.Subscription = r.PayerAuthorisation, r.Payment, r.Payout, r.Refund, r.Subscription
+	e.ParentEvent, e.NewMandate = r.ParentEvent, r.NewMandate
+	return nil
+}
+
+// EnrichCache shares the resources fetched by Enrichers between the events
+// of a batch, so each resource is only fetched once. It is safe for
+// concurrent use.
+type EnrichCache struct {
+	mu      sync.Mutex
+	entries map[enrichKey]*enrichEntry
+}
+
+type enrichKey struct {
+	kind string
+	id   string
+}
+
+type enrichEntry struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+// NewEnrichCache returns an empty EnrichCache.
+func NewEnrichCache() *EnrichCache {
+	return &EnrichCache{
+		entries: make(map[enrichKey]*enrichEntry),
+	}
+}
+
+// get returns the cached value for the key, calling fetch if there is none.
+// Concurrent callers for the same key wait for the first fetch. Failed
+// fetches aren't cached.
+func (c *EnrichCache) get(ctx context.Context, key enrichKey, fetch func() (interface{}, error)) (interface{}, error) {
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok {
+		c.mu.Unlock()
+		select {
+		case <-e.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if e.err == nil {
+			return e.value, nil
+		}
+		return c.get(ctx, key, fetch)
+	}
+	e := &enrichEntry{done: make(chan struct{})}
+	c.entries[key] = e
+	c.mu.Unlock()
+
+	e.value, e.err = fetch()
+	if e.err != nil {
+		c.mu.Lock()
+		delete(c.entries, key)
+		c.mu.Unlock()
+	}
+	close(e.done)
+	return e.value, e.err
+}
+
+type enrichCacheKey struct{}
+
+// ContextWithEnrichCache returns a copy of ctx carrying the cache, used by
+// Enrichers called with the returned context. WebhookHandler and Worker
+// set up a fresh cache for every webhook batch.
+func ContextWithEnrichCache(ctx context.Context, c *EnrichCache) context.Context {
+	return context.WithValue(ctx, enrichCacheKey{}, c)
+}
+
+func enrichCacheFromContext(ctx context.Context) (*EnrichCache, bool) {
+	c, ok := ctx.Value(enrichCacheKey{}).(*EnrichCache)
+	return c, ok
+}
+
+// EnricherOption is used to configure an Enricher
+type EnricherOption func(*Enricher) error
+
+// WithParentEvent makes the Enricher fetch the event linked as parent_event.
+func WithParentEvent() EnricherOption {
+	return func(en *Enricher) error {
+		en.parentEvent = true
+		return nil
+	}
+}
+
+// WithNewMandate makes the Enricher fetch the mandate linked as new_mandate.
+func WithNewMandate() EnricherOption {
+	return func(en *Enricher) error {
+		en.newMandate = true
+		return nil
+	}
+}
+
+// WithMaxConcurrentFetches limits the number of API requests the Enricher
+// makes at once. It defaults to 4.
+func WithMaxConcurrentFetches(n int) EnricherOption {
+	return func(en *Enricher) error {
+		if n < 1 {
+			return errors.New("max concurrent fetches must be positive")
+		}
+		en.sem = make(chan struct{}, n)
+		return nil
+	}
+}
+
+// Enricher fetches the resources events refer to.
+type Enricher struct {
+	service     *Service
+	parentEvent bool
+	newMandate  bool
+	sem         chan struct{}
+}
+
+// NewEnricher returns an Enricher fetching resources through s.
+func NewEnricher(s *Service, opts ...EnricherOption) (*Enricher, error) {
+	if s == nil {
+		return nil, errors.New("missing service")
+	}
+	en := &Enricher{
+		service: s,
+		sem:     make(chan struct{}, 4),
+	}
+	for _, opt := range opts {
+		if err := opt(en); err != nil {
+			return nil, err
+		}
+	}
+	return en, nil
+}
+
+// Enrich fetches the resources the event refers to. Resources already in
+// the EnrichCache carried by ctx aren't fetched again.
+func (en *Enricher) Enrich(ctx context.Context, e Event) (*EnrichedEvent, error) {
+	cache, ok := enrichCacheFromContext(ctx)
+	if !ok {
+		cache = NewEnrichCache()
+	}
+	return en.enrich(ctx, cache, e)
+}
+
+// EnrichAll fetches the resources the events refer to, enriching the events
+// concurrently and sharing fetched resources between them.
+func (en *Enricher) EnrichAll(ctx context.Context, events []Event) ([]*EnrichedEvent, error) {
+	cache, ok := enrichCacheFromContext(ctx)
+	if !ok {
+		cache = NewEnrichCache()
+	}
+
+	enriched := make([]*EnrichedEvent, len(events))
+	errs := make([]error, len(events))
+	var wg sync.WaitGroup
+	for i := range events {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			enriched[i], errs[i] = en.enrich(ctx, cache, events[i])
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return enriched, nil
+}
+
+// Handler returns an EventHandler enriching events before passing them to fn.
+func (en *Enricher) Handler(fn func(context.Context, *EnrichedEvent) error) ContextEventHandlerFunc {
+	return func(ctx context.Context, e Event) error {
+		enriched, err := en.Enrich(ctx, e)
+		if err != nil {
+			return err
+		}
+		return fn(ctx, enriched)
+	}
+}
+
+func (en *Enricher) enrich(ctx context.Context, cache *EnrichCache, e Event) (*EnrichedEvent, error) {
+	enriched := &EnrichedEvent{Event: e}
+
+	if id := e.ResourceID(); id != "" {
+		if err := en.fetchPrimary(ctx, cache, enriched, id); err != nil {
+			return nil, err
+		}
+	}
+
+	if e.Links == nil {
+		return enriched, nil
+	}
+	if en.parentEvent && e.Links.ParentEvent != "" {
+		v, err := en.fetch(ctx, cache, "events", e.Links.ParentEvent, func() (interface{}, error) {
+			return en.service.Events.Get(ctx, e.Links.ParentEvent)
+		})
+		if err != nil {
+			return nil, err
+		}
+		enriched.ParentEvent = v.(*Event)
+	}
+	if en.newMandate && e.Links.NewMandate != "" {
+		v, err := en.fetch(ctx, cache, string(ResourceMandates), e.Links.NewMandate, func() (interface{}, error) {
+			return en.service.Mandates.Get(ctx, e.Links.NewMandate)
+		})
+		if err != nil {
+			return nil, err
+		}
+		enriched.NewMandate = v.(*Mandate)
+	}
+	return enriched, nil
+}
+
+func (en *Enricher) fetchPrimary(ctx context.Context, cache *EnrichCache, enriched *EnrichedEvent, id string) error {
+	var fetch func() (interface{}, error)
+	switch enriched.ResourceType {
+	case ResourceBillingRequests:
+		fetch = func() (interface{}, error) { return en.service.BillingRequests.Get(ctx, id) }
+	case ResourceCreditors:
+		fetch = func() (interface{}, error) { return en.service.Creditors.Get(ctx, id, CreditorGetParams{}) }
+	case ResourceCustomers:
+		fetch = func() (interface{}, error) { return en.service.Customers.Get(ctx, id) }
+	case ResourceInstalmentSchedules:
+		fetch = func() (interface{}, error) { return en.service.InstalmentSchedules.Get(ctx, id) }
+	case ResourceMandates:
+		fetch = func() (interface{}, error) { return en.service.Mandates.Get(ctx, id) }
+	case ResourcePayerAuthorisations:
+		fetch = func() (interface{}, error) { return en.service.PayerAuthorisations.Get(ctx, id) }
+	case ResourcePayments:
+		fetch = func() (interface{}, error) { return en.service.Payments.Get(ctx, id) }
+	case ResourcePayouts:
+		fetch = func() (interface{}, error) { return en.service.Payouts.Get(ctx, id) }
+	case ResourceRefunds:
+		fetch = func() (interface{}, error) { return en.service.Refunds.Get(ctx, id) }
+	case ResourceSubscriptions:
+		fetch = func() (interface{}, error) { return en.service.Subscriptions.Get(ctx, id) }
+	default:
+		return nil
+	}
+
+	v, err := en.fetch(ctx, cache, string(enriched.ResourceType), id, fetch)
+	if err != nil {
+		return err
+	}
+
+	switch r := v.(type) {
+	case *BillingRequest:
+		enriched.BillingRequest = r
+	case *Creditor:
+		enriched.Creditor = r
+	case *Customer:
+		enriched.Customer = r
+	case *InstalmentSchedule:
+		enriched.InstalmentSchedule = r
+	case *Mandate:
+		enriched.Mandate = r
+	case *PayerAuthorisation:
+		enriched.PayerAuthorisation = r
+	case *Payment:
+		enriched.Payment = r
+	case *Payout:
+		enriched.Payout = r
+	case *Refund:
+		enriched.Refund = r
+	case *Subscription:
+		enriched.Subscription = r
+	}
+	return nil
+}
+
+// fetch gets the resource from the cache, calling fn within the concurrency
+// limit if it isn't there.
+func (en *Enricher) fetch(ctx context.Context, cache *EnrichCache, kind, id string, fn func() (interface{}, error)) (interface{}, error) {
+	return cache.get(ctx, enrichKey{kind: kind, id: id}, func() (interface{}, error) {
+		select {
+		case en.sem <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		defer func() { <-en.sem }()
+		return fn()
+	})
+}
@@ -0,0 +1,146 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func runGetServer(t *testing.T, requests map[string]int, mu *sync.Mutex) *httptest.Server {
+	fixtures := map[string]string{
+		"/payments/": "testdata/payments.json",
+		"/mandates/": "testdata/mandates.json",
+		"/events/":   "testdata/events.json",
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.URL.Path]++
+		mu.Unlock()
+
+		for prefix, file := range fixtures {
+			if !strings.HasPrefix(r.URL.Path, prefix) {
+				continue
+			}
+			raw, err := os.ReadFile(file)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			var fixture map[string]struct {
+				Body json.RawMessage `json:"body"`
+			}
+			json.Unmarshal(raw, &fixture)
+			w.Write(fixture["get"].Body)
+			return
+		}
+		http.NotFound(w, r)
+	}))
+}
+
+func TestEnricherFetchesLinkedResourcesOnce(t *testing.T) {
+	var mu sync.Mutex
+	requests := map[string]int{}
+	server := runGetServer(t, requests, &mu)
+	defer server.Close()
+
+	client, err := getClient(t, server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	en, err := NewEnricher(client, WithParentEvent(), WithNewMandate(), WithMaxConcurrentFetches(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var events []Event
+	for i := 0; i < 10; i++ {
+		events = append(events, Event{
+			ResourceType: ResourcePayments,
+			Action:       ActionConfirmed,
+			Links:        &EventLinks{Payment: "PM123", ParentEvent: "EV123"},
+		})
+	}
+	events = append(events, Event{
+		ResourceType: ResourceMandates,
+		Action:       ActionReplaced,
+		Links:        &EventLinks{Mandate: "MD123", NewMandate: "MD456"},
+	})
+
+	enriched, err := en.EnrichAll(context.TODO(), events)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, e := range enriched[:10] {
+		if e.Payment == nil || e.Payment.Id != "PM123" {
+			t.Fatalf("Expected payment PM123, got %v", e.Payment)
+		}
+		if e.ParentEvent == nil {
+			t.Fatalf("Expected parent event")
+		}
+		if e.Mandate != nil {
+			t.Fatalf("Expected no mandate on payment event")
+		}
+	}
+	if enriched[10].Mandate == nil || enriched[10].NewMandate == nil {
+		t.Fatalf("Expected mandate and new mandate")
+	}
+
+	expected := map[string]int{"/payments/PM123": 1, "/events/EV123": 1, "/mandates/MD123": 1, "/mandates/MD456": 1}
+	for path, n := range expected {
+		if requests[path] != n {
+			t.Fatalf("%s: Expected %d requests, got %d", path, n, requests[path])
+		}
+	}
+}
+
+func TestWebhookSharesEnrichCacheAcrossBatch(t *testing.T) {
+	var mu sync.Mutex
+	requests := map[string]int{}
+	server := runGetServer(t, requests, &mu)
+	defer server.Close()
+
+	client, err := getClient(t, server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	en, err := NewEnricher(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var calls int
+	wh, err := NewWebhookHandler("testing", en.Handler(func(ctx context.Context, e *EnrichedEvent) error {
+		calls++
+		if e.Payment == nil {
+			t.Fatalf("Expected payment")
+		}
+		return nil
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := []byte(`{"events":[` +
+		`{"id":"EV1","resource_type":"payments","action":"confirmed","links":{"payment":"PM123"}},` +
+		`{"id":"EV2","resource_type":"payments","action":"paid_out","links":{"payment":"PM123"}}]}`)
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "/webhook", strings.NewReader(string(body)))
+	r.Header.Set("Webhook-Signature", signWebhookBody("testing", body))
+	wh.ServeHTTP(w, r)
+
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("Expected %d, got %d", http.StatusNoContent, w.Code)
+	}
+	if calls != 2 {
+		t.Fatalf("Expected 2 calls, got %d", calls)
+	}
+	if requests["/payments/PM123"] != 1 {
+		t.Fatalf("Expected 1 request, got %d", requests["/payments/PM123"])
+	}
+}
@@ -0,0 +1,533 @@
+package gocardless
+
+// PaymentStatus is the status of a payment.
+type PaymentStatus string
+
+const (
+	PaymentStatusPendingCustomerApproval PaymentStatus = "pending_customer_approval"
+	PaymentStatusPendingSubmission       PaymentStatus = "pending_submission"
+	PaymentStatusSubmitted               PaymentStatus = "submitted"
+	PaymentStatusConfirmed               PaymentStatus = "confirmed"
+	PaymentStatusPaidOut                 PaymentStatus = "paid_out"
+	PaymentStatusCancelled               PaymentStatus = "cancelled"
+	PaymentStatusCustomerApprovalDenied  PaymentStatus = "customer_approval_denied"
+	PaymentStatusFailed                  PaymentStatus = "failed"
+	PaymentStatusChargedBack             PaymentStatus = "charged_back"
+)
+
+// IsValid reports whether s is a documented payment status. Values added to the API
+// after this library was released decode fine but aren't valid.
+func (s PaymentStatus) IsValid() bool {
+	switch s {
+	case PaymentStatusPendingCustomerApproval,
+		PaymentStatusPendingSubmission,
+		PaymentStatusSubmitted,
+		PaymentStatusConfirmed,
+		PaymentStatusPaidOut,
+		PaymentStatusCancelled,
+		PaymentStatusCustomerApprovalDenied,
+		PaymentStatusFailed,
+		PaymentStatusChargedBack:
+		return true
+	}
+	return false
+}
+
+// IsTerminal reports whether s is a final payment status, from which the
+// resource never moves to another status.
+func (s PaymentStatus) IsTerminal() bool {
+	switch s {
+	case PaymentStatusCancelled, PaymentStatusCustomerApprovalDenied:
+		return true
+	}
+	return false
+}
+
+// MandateStatus is the status of a mandate.
+type MandateStatus string
+
+const (
+	MandateStatusPendingCustomerApproval MandateStatus = "pending_customer_approval"
+	MandateStatusPendingSubmission       MandateStatus = "pending_submission"
+	MandateStatusSubmitted               MandateStatus = "submitted"
+	MandateStatusActive                  MandateStatus = "active"
+	MandateStatusSuspendedByPayer        MandateStatus = "suspended_by_payer"
+	MandateStatusFailed                  MandateStatus = "failed"
+	MandateStatusCancelled               MandateStatus = "cancelled"
+	MandateStatusExpired                 MandateStatus = "expired"
+	MandateStatusConsumed                MandateStatus = "consumed"
+	MandateStatusBlocked                 MandateStatus = "blocked"
+)
+
+// IsValid reports whether s is a documented mandate status. Values added to the API
+// after this library was released decode fine but aren't valid.
+func (s MandateStatus) IsValid() bool {
+	switch s {
+	case MandateStatusPendingCustomerApproval,
+		MandateStatusPendingSubmission,
+		MandateStatusSubmitted,
+		MandateStatusActive,
+		MandateStatusSuspendedByPayer,
+		MandateStatusFailed,
+		MandateStatusCancelled,
+		MandateStatusExpired,
+		MandateStatusConsumed,
+		MandateStatusBlocked:
+		return true
+	}
+	return false
+}
+
+// IsTerminal reports whether s is a final mandate status, from which the
+// resource never moves to another status.
+func (s MandateStatus) IsTerminal() bool {
+	switch s {
+	case MandateStatusFailed, MandateStatusConsumed, MandateStatusBlocked:
+		return true
+	}
+	return false
+}
+
+// SubscriptionStatus is the status of a subscription.
+type SubscriptionStatus string
+
+const (
+	SubscriptionStatusPendingCustomerApproval SubscriptionStatus = "pending_customer_approval"
+	SubscriptionStatusCustomerApprovalDenied  SubscriptionStatus = "customer_approval_denied"
+	SubscriptionStatusActive                  SubscriptionStatus = "active"
+	SubscriptionStatusFinished                SubscriptionStatus = "finished"
+	SubscriptionStatusCancelled               SubscriptionStatus = "cancelled"
+	SubscriptionStatusPaused                  SubscriptionStatus = "paused"
+)
+
+// IsValid reports whether s is a documented subscription status. Values added to the API
+// after this library was released decode fine but aren't valid.
+func (s SubscriptionStatus) IsValid() bool {
+	switch s {
+	case SubscriptionStatusPendingCustomerApproval,
+		SubscriptionStatusCustomerApprovalDenied,
+		SubscriptionStatusActive,
+		SubscriptionStatusFinished,
+		SubscriptionStatusCancelled,
+		SubscriptionStatusPaused:
+		return true
+	}
+	return false
+}
+
+// IsTerminal reports whether s is a final subscription status, from which the
+// resource never moves to another status.
+func (s SubscriptionStatus) IsTerminal() bool {
+	switch s {
+	case SubscriptionStatusCustomerApprovalDenied, SubscriptionStatusFinished, SubscriptionStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// RefundStatus is the status of a refund.
+type RefundStatus string
+
+const (
+	RefundStatusCreated           RefundStatus = "created"
+	RefundStatusPendingSubmission RefundStatus = "pending_submission"
+	RefundStatusSubmitted         RefundStatus = "submitted"
+	RefundStatusPaid              RefundStatus = "paid"
+	RefundStatusCancelled         RefundStatus = "cancelled"
+	RefundStatusBounced           RefundStatus = "bounced"
+	RefundStatusFundsReturned     RefundStatus = "funds_returned"
+)
+
+// IsValid reports whether s is a documented refund status. Values added to the API
+// after this library was released decode fine but aren't valid.
+func (s RefundStatus) IsValid() bool {
+	switch s {
+	case RefundStatusCreated,
+		RefundStatusPendingSubmission,
+		RefundStatusSubmitted,
+		RefundStatusPaid,
+		RefundStatusCancelled,
+		RefundStatusBounced,
+		RefundStatusFundsReturned:
+		return true
+	}
+	return false
+}
+
+// IsTerminal reports whether s is a final refund status, from which the
+// resource never moves to another status.
+func (s RefundStatus) IsTerminal() bool {
+	switch s {
+	case RefundStatusCancelled, RefundStatusBounced, RefundStatusFundsReturned:
+		return true
+	}
+	return false
+}
+
+// PayoutStatus is the status of a payout.
+type PayoutStatus string
+
+const (
+	PayoutStatusPending PayoutStatus = "pending"
+	PayoutStatusPaid    PayoutStatus = "paid"
+	PayoutStatusBounced PayoutStatus = "bounced"
+)
+
+// IsValid reports whether s is a documented payout status. Values added to the API
+// after this library was released decode fine but aren't valid.
+func (s PayoutStatus) IsValid() bool {
+	switch s {
+	case PayoutStatusPending,
+		PayoutStatusPaid,
+		PayoutStatusBounced:
+		return true
+	}
+	return false
+}
+
+// IsTerminal reports whether s is a final payout status, from which the
+// resource never moves to another status.
+func (s PayoutStatus) IsTerminal() bool {
+	switch s {
+	case PayoutStatusPaid, PayoutStatusBounced:
+		return true
+	}
+	return false
+}
+
+// InstalmentScheduleStatus is the status of an instalment schedule.
+type InstalmentScheduleStatus string
+
+const (
+	InstalmentScheduleStatusPending        InstalmentScheduleStatus = "pending"
+	InstalmentScheduleStatusActive         InstalmentScheduleStatus = "active"
+	InstalmentScheduleStatusCreationFailed InstalmentScheduleStatus = "creation_failed"
+	InstalmentScheduleStatusCompleted      InstalmentScheduleStatus = "completed"
+	InstalmentScheduleStatusCancelled      InstalmentScheduleStatus = "cancelled"
+	InstalmentScheduleStatusErrored        InstalmentScheduleStatus = "errored"
+)
+
+// IsValid reports whether s is a documented instalment schedule status. Values added to the API
+// after this library was released decode fine but aren't valid.
+func (s InstalmentScheduleStatus) IsValid() bool {
+	switch s {
+	case InstalmentScheduleStatusPending,
+		InstalmentScheduleStatusActive,
+		InstalmentScheduleStatusCreationFailed,
+		InstalmentScheduleStatusCompleted,
+		InstalmentScheduleStatusCancelled,
+		InstalmentScheduleStatusErrored:
+		return true
+	}
+	return false
+}
+
+// IsTerminal reports whether s is a final instalment schedule status, from which the
+// resource never moves to another status.
+func (s InstalmentScheduleStatus) IsTerminal() bool {
+	switch s {
+	case InstalmentScheduleStatusCreationFailed, InstalmentScheduleStatusCompleted, InstalmentScheduleStatusCancelled, InstalmentScheduleStatusErrored:
+		return true
+	}
+	return false
+}
+
+// BillingRequestStatus is the status of a billing request.
+type BillingRequestStatus string
+
+const (
+	BillingRequestStatusPending       BillingRequestStatus = "pending"
+	BillingRequestStatusReadyToFulfil BillingRequestStatus = "ready_to_fulfil"
+	BillingRequestStatusFulfilling    BillingRequestStatus = "fulfilling"
+	BillingRequestStatusFulfilled     BillingRequestStatus = "fulfilled"
+	BillingRequestStatusCancelled     BillingRequestStatus = "cancelled"
+)
+
+// IsValid reports whether s is a documented billing request status. Values added to the API
+// after this library was released decode fine but aren't valid.
+func (s BillingRequestStatus) IsValid() bool {
+	switch s {
+	case BillingRequestStatusPending,
+		BillingRequestStatusReadyToFulfil,
+		BillingRequestStatusFulfilling,
+		BillingRequestStatusFulfilled,
+		BillingRequestStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// IsTerminal reports whether s is a final billing request status, from which the
+// resource never moves to another status.
+func (s BillingRequestStatus) IsTerminal() bool {
+	switch s {
+	case BillingRequestStatusFulfilled, BillingRequestStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// Scheme is a Direct Debit or bank payment scheme.
+type Scheme string
+
+const (
+	SchemeAch                       Scheme = "ach"
+	SchemeAutogiro                  Scheme = "autogiro"
+	SchemeBacs                      Scheme = "bacs"
+	SchemeBecs                      Scheme = "becs"
+	SchemeBecsNz                    Scheme = "becs_nz"
+	SchemeBetalingsservice          Scheme = "betalingsservice"
+	SchemeFasterPayments            Scheme = "faster_payments"
+	SchemePad                       Scheme = "pad"
+	SchemePayTo                     Scheme = "pay_to"
+	SchemeSepaCore                  Scheme = "sepa_core"
+	SchemeSepaCreditTransfer        Scheme = "sepa_credit_transfer"
+	SchemeSepaInstantCreditTransfer Scheme = "sepa_instant_credit_transfer"
+)
+
+// IsValid reports whether s is a documented scheme. Values added to the API
+// after this library was released decode fine but aren't valid.
+func (s Scheme) IsValid() bool {
+	switch s {
+	case SchemeAch,
+		SchemeAutogiro,
+		SchemeBacs,
+		SchemeBecs,
+		SchemeBecsNz,
+		SchemeBetalingsservice,
+		SchemeFasterPayments,
+		SchemePad,
+		SchemePayTo,
+		SchemeSepaCore,
+		SchemeSepaCreditTransfer,
+		SchemeSepaInstantCreditTransfer:
+		return true
+	}
+	return false
+}
+
+// IntervalUnit is the unit of the interval between the payments of a subscription or instalment schedule.
+type IntervalUnit string
+
+const (
+	IntervalUnitWeekly  IntervalUnit = "weekly"
+	IntervalUnitMonthly IntervalUnit = "monthly"
+	IntervalUnitYearly  IntervalUnit = "yearly"
+)
+
+// IsValid reports whether s is a documented interval unit. Values added to the API
+// after this library was released decode fine but aren't valid.
+func (s IntervalUnit) IsValid() bool {
+	switch s {
+	case IntervalUnitWeekly,
+		IntervalUnitMonthly,
+		IntervalUnitYearly:
+		return true
+	}
+	return false
+}
+
+// ResourceType is the type of resource an event is about.
+type ResourceType string
+
+const (
+	ResourceBillingRequests     ResourceType = "billing_requests"
+	ResourceCreditors           ResourceType = "creditors"
+	ResourceCustomers           ResourceType = "customers"
+	ResourceInstalmentSchedules ResourceType = "instalment_schedules"
+	ResourceMandates            ResourceType = "mandates"
+	ResourceOrganisations       ResourceType = "organisations"
+	ResourcePayerAuthorisations ResourceType = "payer_authorisations"
+	ResourcePayments            ResourceType = "payments"
+	ResourcePayouts             ResourceType = "payouts"
+	ResourceRefunds             ResourceType = "refunds"
+	ResourceSubscriptions       ResourceType = "subscriptions"
+)
+
+// IsValid reports whether s is a documented resource type. Values added to the API
+// after this library was released decode fine but aren't valid.
+func (s ResourceType) IsValid() bool {
+	switch s {
+	case ResourceBillingRequests,
+		ResourceCreditors,
+		ResourceCustomers,
+		ResourceInstalmentSchedules,
+		ResourceMandates,
+		ResourceOrganisations,
+		ResourcePayerAuthorisations,
+		ResourcePayments,
+		ResourcePayouts,
+		ResourceRefunds,
+		ResourceSubscriptions:
+		return true
+	}
+	return false
+}
+
+// EventAction is what happened to the resource an event is about. The same action can be used by several resource types.
+type EventAction string
+
+const (
+	ActionAccountAutoFrozen           EventAction = "account_auto_frozen"
+	ActionAccountAutoFrozenReverted   EventAction = "account_auto_frozen_reverted"
+	ActionActive                      EventAction = "active"
+	ActionAmended                     EventAction = "amended"
+	ActionBankAuthorisationAuthorised EventAction = "bank_authorisation_authorised"
+	ActionBankAuthorisationDenied     EventAction = "bank_authorisation_denied"
+	ActionBankAuthorisationExpired    EventAction = "bank_authorisation_expired"
+	ActionBankAuthorisationFailed     EventAction = "bank_authorisation_failed"
+	ActionBlocked                     EventAction = "blocked"
+	ActionCancelled                   EventAction = "cancelled"
+	ActionChargebackCancelled         EventAction = "chargeback_cancelled"
+	ActionChargebackSettled           EventAction = "chargeback_settled"
+	ActionChargedBack                 EventAction = "charged_back"
+	ActionCollectBankAccount          EventAction = "collect_bank_account"
+	ActionCollectCustomerDetails      EventAction = "collect_customer_details"
+	ActionCompleted                   EventAction = "completed"
+	ActionConfirmed                   EventAction = "confirmed"
+	ActionConsumed                    EventAction = "consumed"
+	ActionCreated                     EventAction = "created"
+	ActionCreationFailed              EventAction = "creation_failed"
+	ActionCustomerApprovalDenied      EventAction = "customer_approval_denied"
+	ActionCustomerApprovalGranted     EventAction = "customer_approval_granted"
+	ActionCustomerApprovalSkipped     EventAction = "customer_approval_skipped"
+	ActionErrored                     EventAction = "errored"
+	ActionExpired                     EventAction = "expired"
+	ActionFailed                      EventAction = "failed"
+	ActionFinished                    EventAction = "finished"
+	ActionFlowCreated                 EventAction = "flow_created"
+	ActionFlowExited                  EventAction = "flow_exited"
+	ActionFlowVisited                 EventAction = "flow_visited"
+	ActionFulfilled                   EventAction = "fulfilled"
+	ActionFundsReturned               EventAction = "funds_returned"
+	ActionFxRateConfirmed             EventAction = "fx_rate_confirmed"
+	ActionLateFailureSettled          EventAction = "late_failure_settled"
+	ActionNewPayoutCurrencyAdded      EventAction = "new_payout_currency_added"
+	ActionPaid                        EventAction = "paid"
+	ActionPaidOut                     EventAction = "paid_out"
+	ActionPaused                      EventAction = "paused"
+	ActionPayerDetailsConfirmed       EventAction = "payer_details_confirmed"
+	ActionPaymentCreated              EventAction = "payment_created"
+	ActionRefundSettled               EventAction = "refund_settled"
+	ActionReinstated                  EventAction = "reinstated"
+	ActionReplaced                    EventAction = "replaced"
+	ActionResubmissionRequested       EventAction = "resubmission_requested"
+	ActionResumed                     EventAction = "resumed"
+	ActionScheduledPause              EventAction = "scheduled_pause"
+	ActionScheduledPauseCancelled     EventAction = "scheduled_pause_cancelled"
+	ActionSubmitted                   EventAction = "submitted"
+	ActionSurchargeFeeCredited        EventAction = "surcharge_fee_credited"
+	ActionSurchargeFeeDebited         EventAction = "surcharge_fee_debited"
+	ActionTaxExchangeRatesConfirmed   EventAction = "tax_exchange_rates_confirmed"
+	ActionTransferred                 EventAction = "transferred"
+	ActionUpdated                     EventAction = "updated"
+)
+
+// IsValid reports whether s is a documented event action. Values added to the API
+// after this library was released decode fine but aren't valid.
+func (s EventAction) IsValid() bool {
+	switch s {
+	case ActionAccountAutoFrozen,
+		ActionAccountAutoFrozenReverted,
+		ActionActive,
+		ActionAmended,
+		ActionBankAuthorisationAuthorised,
+		ActionBankAuthorisationDenied,
+		ActionBankAuthorisationExpired,
+		ActionBankAuthorisationFailed,
+		ActionBlocked,
+		ActionCancelled,
+		ActionChargebackCancelled,
+		ActionChargebackSettled,
+		ActionChargedBack,
+		ActionCollectBankAccount,
+		ActionCollectCustomerDetails,
+		ActionCompleted,
+		ActionConfirmed,
+		ActionConsumed,
+		ActionCreated,
+		ActionCreationFailed,
+		ActionCustomerApprovalDenied,
+		ActionCustomerApprovalGranted,
+		ActionCustomerApprovalSkipped,
+		ActionErrored,
+		ActionExpired,
+		ActionFailed,
+		ActionFinished,
+		ActionFlowCreated,
+		ActionFlowExited,
+		ActionFlowVisited,
+		ActionFulfilled,
+		ActionFundsReturned,
+		ActionFxRateConfirmed,
+		ActionLateFailureSettled,
+		ActionNewPayoutCurrencyAdded,
+		ActionPaid,
+		ActionPaidOut,
+		ActionPaused,
+		ActionPayerDetailsConfirmed,
+		ActionPaymentCreated,
+		ActionRefundSettled,
+		ActionReinstated,
+		ActionReplaced,
+		ActionResubmissionRequested,
+		ActionResumed,
+		ActionScheduledPause,
+		ActionScheduledPauseCancelled,
+		ActionSubmitted,
+		ActionSurchargeFeeCredited,
+		ActionSurchargeFeeDebited,
+		ActionTaxExchangeRatesConfirmed,
+		ActionTransferred,
+		ActionUpdated:
+		return true
+	}
+	return false
+}
+
+var resourceActions = map[ResourceType][]EventAction{
+	ResourceBillingRequests: {
+		ActionCreated, ActionCollectCustomerDetails, ActionCollectBankAccount, ActionBankAuthorisationAuthorised, ActionBankAuthorisationDenied, ActionBankAuthorisationExpired, ActionBankAuthorisationFailed, ActionPayerDetailsConfirmed, ActionFulfilled, ActionCancelled, ActionFailed, ActionFlowCreated, ActionFlowVisited, ActionFlowExited,
+	},
+	ResourceCreditors: {
+		ActionUpdated, ActionNewPayoutCurrencyAdded, ActionAccountAutoFrozen, ActionAccountAutoFrozenReverted,
+	},
+	ResourceInstalmentSchedules: {
+		ActionCreated, ActionCreationFailed, ActionCompleted, ActionCancelled, ActionErrored, ActionResumed,
+	},
+	ResourceMandates: {
+		ActionCreated, ActionCustomerApprovalGranted, ActionCustomerApprovalSkipped, ActionActive, ActionCancelled, ActionFailed, ActionTransferred, ActionExpired, ActionSubmitted, ActionResubmissionRequested, ActionReinstated, ActionReplaced, ActionConsumed, ActionBlocked,
+	},
+	ResourcePayerAuthorisations: {
+		ActionCompleted, ActionFailed,
+	},
+	ResourcePayments: {
+		ActionCreated, ActionCustomerApprovalGranted, ActionCustomerApprovalDenied, ActionSubmitted, ActionConfirmed, ActionChargebackCancelled, ActionPaidOut, ActionLateFailureSettled, ActionChargebackSettled, ActionSurchargeFeeDebited, ActionSurchargeFeeCredited, ActionFailed, ActionChargedBack, ActionCancelled, ActionResubmissionRequested,
+	},
+	ResourcePayouts: {
+		ActionPaid, ActionFxRateConfirmed, ActionTaxExchangeRatesConfirmed,
+	},
+	ResourceRefunds: {
+		ActionCreated, ActionFailed, ActionPaid, ActionRefundSettled, ActionFundsReturned,
+	},
+	ResourceSubscriptions: {
+		ActionCreated, ActionCustomerApprovalGranted, ActionCustomerApprovalDenied, ActionPaymentCreated, ActionCancelled, ActionFinished, ActionAmended, ActionPaused, ActionResumed, ActionScheduledPause, ActionScheduledPauseCancelled,
+	},
+}
+
+// Actions returns the documented actions of events about resources of type
+// s.
+func (s ResourceType) Actions() []EventAction {
+	return append([]EventAction(nil), resourceActions[s]...)
+}
+
+// IsValidFor reports whether s is a documented action of events about
+// resources of type rt.
+func (s EventAction) IsValidFor(rt ResourceType) bool {
+	for _, a := range resourceActions[rt] {
+		if a == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package gocardless
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestEnumsDecodeUnknownValues(t *testing.T) {
+	var p Payment
+	err := json.Unmarshal([]byte(`{"status":"some_future_status"}`), &p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Status != "some_future_status" {
+		t.Fatalf("Expected %q, got %q", "some_future_status", p.Status)
+	}
+	if p.Status.IsValid() {
+		t.Fatalf("Expected unknown status not to be valid")
+	}
+
+	out, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != `{"status":"some_future_status"}` {
+		t.Fatalf("Expected status to round trip, got %s", out)
+	}
+}
+
+func TestEnumHelpers(t *testing.T) {
+	tests := []struct {
+		name     string
+		got      bool
+		expected bool
+	}{
+		{"paid_out payment is valid", PaymentStatusPaidOut.IsValid(), true},
+		{"cancelled payment is terminal", PaymentStatusCancelled.IsTerminal(), true},
+		{"failed payment can be retried", PaymentStatusFailed.IsTerminal(), false},
+		{"cancelled mandate can be reinstated", MandateStatusCancelled.IsTerminal(), false},
+		{"finished subscription is terminal", SubscriptionStatusFinished.IsTerminal(), true},
+		{"paid payout is terminal", PayoutStatusPaid.IsTerminal(), true},
+		{"bacs is valid", SchemeBacs.IsValid(), true},
+		{"bac is not valid", Scheme("bac").IsValid(), false},
+		{"monthly is valid", IntervalUnitMonthly.IsValid(), true},
+		{"payments is valid", ResourcePayments.IsValid(), true},
+		{"payment is not valid", ResourceType("payment").IsValid(), false},
+		{"paid_out is a payment action", ActionPaidOut.IsValidFor(ResourcePayments), true},
+		{"paid_out is not a mandate action", ActionPaidOut.IsValidFor(ResourceMandates), false},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.expected {
+			t.Fatalf("%s: Expected %v, got %v", tt.name, tt.expected, tt.got)
+		}
+	}
+
+	for rt, actions := range resourceActions {
+		for _, a := range actions {
+			if !a.IsValid() {
+				t.Fatalf("Expected action %q of %q to be valid", a, rt)
+			}
+		}
+	}
+}
+
+func TestEnumsEncodeInQuery(t *testing.T) {
+	v, err := query.Values(MandateListParams{
+		Scheme: []Scheme{SchemeBacs, SchemeSepaCore},
+		Status: []MandateStatus{MandateStatusActive},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "scheme=bacs&scheme=sepa_core&status=active"
+	if v.Encode() != expected {
+		t.Fatalf("Expected %q, got %q", expected, v.Encode())
+	}
+}
@@ -0,0 +1,92 @@
+package gocardless
+
+// Values of EventListParams.Include, naming the resources to return in
+// EventListResult.Linked alongside the events.
+const (
+	IncludeBillingRequest     = "billing_request"
+	IncludeCreditor           = "creditor"
+	IncludeCustomer           = "customer"
+	IncludeInstalmentSchedule = "instalment_schedule"
+	IncludeMandate            = "mandate"
+	IncludePayerAuthorisation = "payer_authorisation"
+	IncludePayment            = "payment"
+	IncludePayout             = "payout"
+	IncludeRefund             = "refund"
+	IncludeSubscription       = "subscription"
+)
+
+// Included returns the resource the event refers to from the linked
+// resources of an event list, or nil if it wasn't included. The result is
+// one of *BillingRequest, *Creditor, *Customer, *InstalmentSchedule,
+// *Mandate, *PayerAuthorisation, *Payment, *Payout, *Refund or
+// *Subscription, according to the event resource type.
+func (e Event) Included(linked *EventListResultLinked) interface{} {
+	id := e.ResourceID()
+	if linked == nil || id == "" {
+		return nil
+	}
+
+	switch e.ResourceType {
+	case ResourceBillingRequests:
+		for i := range linked.BillingRequests {
+			if linked.BillingRequests[i].Id == id {
+				return &linked.BillingRequests[i]
+			}
+		}
+	case ResourceCreditors:
+		for i := range linked.Creditors {
+			if linked.Creditors[i].Id == id {
+				return &linked.Creditors[i]
+			}
+		}
+	case ResourceCustomers:
+		for i := range linked.Customers {
+			if linked.Customers[i].Id == id {
+				return &linked.Customers[i]
+			}
+		}
+	case ResourceInstalmentSchedules:
+		for i := range linked.InstalmentSchedules {
+			if linked.InstalmentSchedules[i].Id == id {
+				return &linked.InstalmentSchedules[i]
+			}
+		}
+	case ResourceMandates:
+		for i := range linked.Mandates {
+			if linked.Mandates[i].Id == id {
+				return &linked.Mandates[i]
+			}
+		}
+	case ResourcePayerAuthorisations:
+		for i := range linked.PayerAuthorisations {
+			if linked.PayerAuthorisations[i].Id == id {
+				return &linked.PayerAuthorisations[i]
+			}
+		}
+	case ResourcePayments:
+		for i := range linked.Payments {
+			if linked.Payments[i].Id == id {
+				return &linked.Payments[i]
+			}
+		}
+	case ResourcePayouts:
+		for i := range linked.Payouts {
+			if linked.Payouts[i].Id == id {
+				return &linked.Payouts[i]
+			}
+		}
+	case ResourceRefunds:
+		for i := range linked.Refunds {
+			if linked.Refunds[i].Id == id {
+				return &linked.Refunds[i]
+			}
+		}
+	case ResourceSubscriptions:
+		for i := range linked.Subscriptions {
+			if linked.Subscriptions[i].Id == id {
+				return &linked.Subscriptions[i]
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package gocardless
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestEventListDecodesLinkedResources(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("include"); got != IncludePayment {
+			t.Errorf("Expected include=%q, got %q", IncludePayment, got)
+		}
+		w.Write([]byte(`{
+			"events": [
+				{"id": "EV1", "resource_type": "payments", "action": "confirmed", "links": {"payment": "PM1"}},
+				{"id": "EV2", "resource_type": "payments", "action": "confirmed", "links": {"payment": "PM2"}},
+				{"id": "EV3", "resource_type": "mandates", "action": "active", "links": {"mandate": "MD1"}}
+			],
+			"linked": {"payments": [{"id": "PM1", "status": "confirmed"}, {"id": "PM2", "status": "paid_out"}]},
+			"meta": {"cursors": {}, "limit": 50}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := getClient(t, server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := client.Events.List(context.TODO(), EventListParams{Include: IncludePayment})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Linked == nil || len(res.Linked.Payments) != 2 {
+		t.Fatalf("Expected 2 linked payments, got %+v", res.Linked)
+	}
+
+	payment, ok := res.Events[1].Included(res.Linked).(*Payment)
+	if !ok || payment.Id != "PM2" {
+		t.Fatalf("Expected payment PM2, got %v", res.Events[1].Included(res.Linked))
+	}
+	if got := res.Events[2].Included(res.Linked); got != nil {
+		t.Fatalf("Expected nil, got %v", got)
+	}
+}
@@ -0,0 +1,205 @@
+package gocardless
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Any matches every resource type or every action when registering a handler
+// on an EventMux.
+const Any = "*"
+
+// ResourceID returns the ID of the resource the event refers to, looked up
+// in the event links according to its resource type.
+func (e Event) ResourceID() string {
+	if e.Links == nil {
+		return ""
+	}
+	switch e.ResourceType {
+	case ResourceBillingRequests:
+		return e.Links.BillingRequest
+	case ResourceCreditors:
+		return e.Links.Creditor
+	case ResourceCustomers:
+		return e.Links.Customer
+	case ResourceInstalmentSchedules:
+		return e.Links.InstalmentSchedule
+	case ResourceMandates:
+		return e.Links.Mandate
+	case ResourceOrganisations:
+		return e.Links.Organisation
+	case ResourcePayerAuthorisations:
+		return e.Links.PayerAuthorisation
+	case ResourcePayments:
+		return e.Links.Payment
+	case ResourcePayouts:
+		return e.Links.Payout
+	case ResourceRefunds:
+		return e.Links.Refund
+	case ResourceSubscriptions:
+		return e.Links.Subscription
+	}
+	return ""
+}
+
+// LinkedEventHandlerFunc handles an event together with the ID of the
+// resource it refers to, e.g. the payment ID for a payment event.
+type LinkedEventHandlerFunc func(e Event, id string) error
+
+type eventRoute struct {
+	resourceType ResourceType
+	action       EventAction
+}
+
+// EventMux dispatches events to handlers registered by resource type and
+// action. It implements EventHandler and ContextEventHandler, so it can be
+// passed to NewWebhookHandler.
+//
+// When several handlers match an event, the most specific one wins: an exact
+// resource type and action match first, then the resource type with Any
+// action, then Any resource type with the action, then Any for both. Events
+// matching no handler are passed to the fallback handler, if any, and are
+// otherwise ignored.
+type EventMux struct {
+	mu       sync.RWMutex
+	routes   map[eventRoute]EventHandler
+	fallback EventHandler
+}
+
+// NewEventMux allocates and returns a new EventMux.
+func NewEventMux() *EventMux {
+	return &EventMux{
+		routes: make(map[eventRoute]EventHandler),
+	}
+}
+
+// Handle registers the handler for the given resource type and action.
+// Either may be Any. Handle panics if a handler already exists for the pair.
+func (m *EventMux) Handle(resourceType ResourceType, action EventAction, h EventHandler) {
+	if h == nil {
+		panic("gocardless: nil event handler")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.routes == nil {
+		m.routes = make(map[eventRoute]EventHandler)
+	}
+	route := eventRoute{resourceType: resourceType, action: action}
+	if _, ok := m.routes[route]; ok {
+		panic(fmt.Sprintf("gocardless: multiple registrations for %s %s", resourceType, action))
+	}
+	m.routes[route] = h
+}
+
+// On registers the handler function for the given resource type and action.
+func (m *EventMux) On(resourceType ResourceType, action EventAction, fn func(Event) error) {
+	m.Handle(resourceType, action, EventHandlerFunc(fn))
+}
+
+// OnContext registers a handler function receiving the context of the
+// webhook request.
+func (m *EventMux) OnContext(resourceType ResourceType, action EventAction, fn func(context.Context, Event) error) {
+	m.Handle(resourceType, action, ContextEventHandlerFunc(fn))
+}
+
+// OnLinked registers a handler function receiving the ID of the resource the
+// event refers to, as returned by Event.ResourceID.
+func (m *EventMux) OnLinked(resourceType ResourceType, action EventAction, fn LinkedEventHandlerFunc) {
+	m.On(resourceType, action, func(e Event) error {
+		return fn(e, e.ResourceID())
+	})
+}
+
+// OnBillingRequest registers a handler for billing request events.
+func (m *EventMux) OnBillingRequest(action EventAction, fn LinkedEventHandlerFunc) {
+	m.OnLinked(ResourceBillingRequests, action, fn)
+}
+
+// OnCreditor registers a handler for creditor events.
+func (m *EventMux) OnCreditor(action EventAction, fn LinkedEventHandlerFunc) {
+	m.OnLinked(ResourceCreditors, action, fn)
+}
+
+// OnCustomer registers a handler for customer events.
+func (m *EventMux) OnCustomer(action EventAction, fn LinkedEventHandlerFunc) {
+	m.OnLinked(ResourceCustomers, action, fn)
+}
+
+// OnInstalmentSchedule registers a handler for instalment schedule events.
+func (m *EventMux) OnInstalmentSchedule(action EventAction, fn LinkedEventHandlerFunc) {
+	m.OnLinked(ResourceInstalmentSchedules, action, fn)
+}
+
+// OnMandate registers a handler for mandate events.
+func (m *EventMux) OnMandate(action EventAction, fn LinkedEventHandlerFunc) {
+	m.OnLinked(ResourceMandates, action, fn)
+}
+
+// OnPayerAuthorisation registers a handler for payer authorisation events.
+func (m *EventMux) OnPayerAuthorisation(action EventAction, fn LinkedEventHandlerFunc) {
+	m.OnLinked(ResourcePayerAuthorisations, action, fn)
+}
+
+// OnPayment registers a handler for payment events.
+func (m *EventMux) OnPayment(action EventAction, fn LinkedEventHandlerFunc) {
+	m.OnLinked(ResourcePayments, action, fn)
+}
+
+// OnPayout registers a handler for payout events.
+func (m *EventMux) OnPayout(action EventAction, fn LinkedEventHandlerFunc) {
+	m.OnLinked(ResourcePayouts, action, fn)
+}
+
+// OnRefund registers a handler for refund events.
+func (m *EventMux) OnRefund(action EventAction, fn LinkedEventHandlerFunc) {
+	m.OnLinked(ResourceRefunds, action, fn)
+}
+
+// OnSubscription registers a handler for subscription events.
+func (m *EventMux) OnSubscription(action EventAction, fn LinkedEventHandlerFunc) {
+	m.OnLinked(ResourceSubscriptions, action, fn)
+}
+
+// Fallback sets the handler called for events matching no registered handler.
+func (m *EventMux) Fallback(h EventHandler) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.fallback = h
+}
+
+// Handler returns the handler to use for the given event, or nil if there is
+// none.
+func (m *EventMux) Handler(e Event) EventHandler {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	candidates := []eventRoute{
+		{resourceType: e.ResourceType, action: e.Action},
+		{resourceType: e.ResourceType, action: Any},
+		{resourceType: Any, action: e.Action},
+		{resourceType: Any, action: Any},
+	}
+	for _, route := range candidates {
+		if h, ok := m.routes[route]; ok {
+			return h
+		}
+	}
+	return m.fallback
+}
+
+// HandleEvent dispatches the event to the matching handler.
+func (m *EventMux) HandleEvent(e Event) error {
+	return m.HandleEventContext(context.Background(), e)
+}
+
+// HandleEventContext dispatches the event to the matching handler, passing
+// ctx along to handlers implementing ContextEventHandler.
+func (m *EventMux) HandleEventContext(ctx context.Context, e Event) error {
+	h := m.Handler(e)
+	if h == nil {
+		return nil
+	}
+	return handleEvent(ctx, h, e)
+}
@@ -0,0 +1,105 @@
+package gocardless
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestEventMuxDispatchesByResourceAndAction(t *testing.T) {
+	var got []string
+
+	mux := NewEventMux()
+	mux.OnPayment(ActionConfirmed, func(e Event, id string) error {
+		got = append(got, "payments.confirmed:"+id)
+		return nil
+	})
+	mux.On(ResourcePayments, Any, func(e Event) error {
+		got = append(got, "payments.*:"+string(e.Action))
+		return nil
+	})
+	mux.On(Any, ActionCancelled, func(e Event) error {
+		got = append(got, "*.cancelled:"+string(e.ResourceType))
+		return nil
+	})
+	mux.Fallback(EventHandlerFunc(func(e Event) error {
+		got = append(got, "fallback:"+e.Id)
+		return nil
+	}))
+
+	events := []Event{
+		{Id: "EV1", ResourceType: ResourcePayments, Action: ActionConfirmed, Links: &EventLinks{Payment: "PM1"}},
+		{Id: "EV2", ResourceType: ResourcePayments, Action: ActionFailed, Links: &EventLinks{Payment: "PM2"}},
+		{Id: "EV3", ResourceType: ResourceMandates, Action: ActionCancelled, Links: &EventLinks{Mandate: "MD1"}},
+		{Id: "EV4", ResourceType: ResourcePayouts, Action: ActionPaid, Links: &EventLinks{Payout: "PO1"}},
+	}
+	for _, e := range events {
+		if err := mux.HandleEvent(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := []string{
+		"payments.confirmed:PM1",
+		"payments.*:failed",
+		"*.cancelled:mandates",
+		"fallback:EV4",
+	}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Fatalf("Expected %q, got %q", expected[i], got[i])
+		}
+	}
+}
+
+func TestEventMuxIgnoresUnhandledEvents(t *testing.T) {
+	mux := NewEventMux()
+	err := mux.HandleEvent(Event{ResourceType: ResourceRefunds, Action: ActionPaid})
+	if err != nil {
+		t.Fatalf("Expected nil, got %v", err)
+	}
+}
+
+func TestEventMuxPanicsOnDuplicateRegistration(t *testing.T) {
+	mux := NewEventMux()
+	mux.On(ResourcePayments, ActionFailed, func(Event) error { return nil })
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Expected panic on duplicate registration")
+		}
+	}()
+	mux.On(ResourcePayments, ActionFailed, func(Event) error { return nil })
+}
+
+func TestEventMuxAsWebhookHandler(t *testing.T) {
+	mux := NewEventMux()
+	mux.OnPayment(ActionFailed, func(e Event, id string) error {
+		return errors.New("failed " + id)
+	})
+
+	wh, err := NewWebhookHandler("testing", mux)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open("testdata/webhook_request.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "/webhook", f)
+	r.Header.Set("Webhook-Signature", "243f3efa57743c24eec7c5e10edc475b547830d256d5b583745afe319dd90936")
+
+	wh.ServeHTTP(w, r)
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("Expected %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+}
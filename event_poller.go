@@ -0,0 +1,383 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// PollerCheckpoint is the position of an EventPoller in the event stream.
+type PollerCheckpoint struct {
+	// Watermark is the creation time of the newest delivered event.
+	Watermark time.Time `json:"watermark"`
+	// Seen holds the IDs and creation times of the delivered events recent
+	// enough to be listed again because of the overlap window.
+	Seen map[string]time.Time `json:"seen,omitempty"`
+}
+
+// CheckpointStore persists the position of an EventPoller.
+type CheckpointStore interface {
+	// Load returns the saved checkpoint, or nil if there is none.
+	Load(ctx context.Context) (*PollerCheckpoint, error)
+	// Save stores the checkpoint.
+	Save(ctx context.Context, c *PollerCheckpoint) error
+}
+
+// MemoryCheckpointStore is a CheckpointStore keeping the checkpoint in
+// memory. It is safe for concurrent use.
+type MemoryCheckpointStore struct {
+	mu         sync.Mutex
+	checkpoint *PollerCheckpoint
+}
+
+// Load returns the saved checkpoint, or nil if there is none.
+func (s *MemoryCheckpointStore) Load(ctx context.Context) (*PollerCheckpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return copyCheckpoint(s.checkpoint), nil
+}
+
+// Save stores the checkpoint.
+func (s *MemoryCheckpointStore) Save(ctx context.Context, c *PollerCheckpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoint = copyCheckpoint(c)
+	return nil
+}
+
+func copyCheckpoint(c *PollerCheckpoint) *PollerCheckpoint {
+	if c == nil {
+		return nil
+	}
+	cp := &PollerCheckpoint{
+		Watermark: c.Watermark,
+		Seen:      make(map[string]time.Time, len(c.Seen)),
+	}
+	for id, t := range c.Seen {
+		cp.Seen[id] = t
+	}
+	return cp
+}
+
+// FileCheckpointStore is a CheckpointStore keeping the checkpoint in a JSON
+// file, replaced atomically on every save.
+type FileCheckpointStore struct {
+	path string
+}
+
+// NewFileCheckpointStore returns a FileCheckpointStore using the file at path.
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{path: path}
+}
+
+// Load reads the checkpoint from the file, returning nil if it doesn't exist.
+func (s *FileCheckpointStore) Load(ctx context.Context) (*PollerCheckpoint, error) {
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var c PollerCheckpoint
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// Save writes the checkpoint to the file.
+func (s *FileCheckpointStore) Save(ctx context.Context, c *PollerCheckpoint) error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), s.path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+// PollerOption is used to configure an EventPoller
+type PollerOption func(*EventPoller) error
+
+// WithCheckpointStore sets where the poller persists its position. Without
+// one, the position is kept in memory.
+func WithCheckpointStore(s CheckpointStore) PollerOption {
+	return func(p *EventPoller) error {
+		p.checkpoints = s
+		return nil
+	}
+}
+
+// WithPollInterval sets the delay between polls made by Run. It defaults to
+// 30 seconds.
+func WithPollInterval(d time.Duration) PollerOption {
+	return func(p *EventPoller) error {
+		if d <= 0 {
+			return errors.New("poll interval must be positive")
+		}
+		p.interval = d
+		return nil
+	}
+}
+
+// WithPollOverlap sets how far before the watermark each poll looks for
+// events, to catch the ones which became visible late. It defaults to one
+// minute.
+func WithPollOverlap(d time.Duration) PollerOption {
+	return func(p *EventPoller) error {
+		if d < 0 {
+			return errors.New("poll overlap must not be negative")
+		}
+		p.overlap = d
+		return nil
+	}
+}
+
+// WithPollFilter sets the parameters used to list events, e.g. to only poll
+// a resource type. Pagination and creation time parameters are managed by
+// the poller and are ignored.
+func WithPollFilter(params EventListParams) PollerOption {
+	return func(p *EventPoller) error {
+		params.After = ""
+		params.Before = ""
+		params.CreatedAt = nil
+		p.params = params
+		return nil
+	}
+}
+
+// WithPollStart sets the creation time events are delivered from when there
+// is no checkpoint yet. It defaults to the time of the first poll.
+func WithPollStart(t time.Time) PollerOption {
+	return func(p *EventPoller) error {
+		p.start = t
+		return nil
+	}
+}
+
+// WithPollMaxPages sets how many pages of events the poller holds in memory
+// at once. When the events since the watermark span more pages, they are
+// listed in narrower ranges of creation time. It defaults to 100.
+func WithPollMaxPages(n int) PollerOption {
+	return func(p *EventPoller) error {
+		if n <= 0 {
+			return errors.New("poll max pages must be positive")
+		}
+		p.maxPages = n
+		return nil
+	}
+}
+
+// EventPoller delivers events by polling the events API, as an alternative
+// to webhooks. Events are delivered in creation order, at least once: an
+// event is only recorded in the checkpoint after its handler succeeds.
+type EventPoller struct {
+	events      EventService
+	handler     EventHandler
+	checkpoints CheckpointStore
+	params      EventListParams
+	interval    time.Duration
+	overlap     time.Duration
+	start       time.Time
+	maxPages    int
+}
+
+// NewEventPoller returns an EventPoller listing events from s and passing
+// them to h.
+func NewEventPoller(s EventService, h EventHandler, opts ...PollerOption) (*EventPoller, error) {
+	if s == nil {
+		return nil, errors.New("missing event service")
+	}
+	if h == nil {
+		return nil, errors.New("missing event handler")
+	}
+	p := &EventPoller{
+		events:      s,
+		handler:     h,
+		checkpoints: &MemoryCheckpointStore{},
+		interval:    30 * time.Second,
+		overlap:     time.Minute,
+		maxPages:    100,
+	}
+	for _, opt := range opts {
+		if err := opt(p); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// Run polls for events until ctx is done or a poll fails.
+func (p *EventPoller) Run(ctx context.Context) error {
+	for {
+		if err := p.Poll(ctx); err != nil {
+			return err
+		}
+
+		timer := time.NewTimer(p.interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Poll delivers the events created since the last delivered one. It stops
+// at the first failing event, which is delivered again by the next poll.
+func (p *EventPoller) Poll(ctx context.Context) error {
+	checkpoint, err := p.checkpoints.Load(ctx)
+	if err != nil {
+		return err
+	}
+	if checkpoint == nil {
+		start := p.start
+		if start.IsZero() {
+			start = time.Now()
+		}
+		checkpoint = &PollerCheckpoint{Watermark: start}
+	}
+	if checkpoint.Seen == nil {
+		checkpoint.Seen = make(map[string]time.Time)
+	}
+
+	p.prune(checkpoint)
+
+	// The API lists events newest first, so a range of creation times is
+	// only handled once all of it is listed. Ranges too large to list
+	// within maxPages are halved until they fit, and the events after them
+	// are listed once they are handled.
+	lower := checkpoint.Watermark.Add(-p.overlap)
+	var upper time.Time
+	for {
+		events, complete, err := p.list(ctx, lower, upper)
+		if err != nil {
+			return err
+		}
+		if !complete {
+			if upper.IsZero() {
+				upper = time.Now()
+				if len(events) > 0 {
+					upper = events[len(events)-1].createdAt.Add(time.Nanosecond)
+				}
+			}
+			width := upper.Sub(lower) / 2
+			if width <= 0 {
+				return fmt.Errorf("more than %d pages of events created at %v", p.maxPages, lower)
+			}
+			upper = lower.Add(width)
+			continue
+		}
+
+		if err := p.deliver(ctx, checkpoint, events); err != nil {
+			return err
+		}
+		if upper.IsZero() {
+			return nil
+		}
+
+		lower, upper = upper, time.Time{}
+	}
+}
+
+// deliver handles the events not seen yet, saving the checkpoint after
+// each of them.
+func (p *EventPoller) deliver(ctx context.Context, checkpoint *PollerCheckpoint, events []polledEvent) error {
+	for _, pe := range events {
+		if _, ok := checkpoint.Seen[pe.event.Id]; ok {
+			continue
+		}
+		if err := handleEvent(ctx, p.handler, pe.event); err != nil {
+			return err
+		}
+
+		checkpoint.Seen[pe.event.Id] = pe.createdAt
+		if pe.createdAt.After(checkpoint.Watermark) {
+			checkpoint.Watermark = pe.createdAt
+			p.prune(checkpoint)
+		}
+		if err := p.checkpoints.Save(ctx, checkpoint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prune forgets the seen events created before the overlap window, which
+// can't be listed again.
+func (p *EventPoller) prune(checkpoint *PollerCheckpoint) {
+	for id, t := range checkpoint.Seen {
+		if t.Before(checkpoint.Watermark.Add(-p.overlap)) {
+			delete(checkpoint.Seen, id)
+		}
+	}
+}
+
+type polledEvent struct {
+	event     Event
+	createdAt time.Time
+}
+
+// list returns the events created since the given time, and before until
+// unless it is zero, oldest first. It stops after maxPages pages, reporting
+// whether every event was listed.
+func (p *EventPoller) list(ctx context.Context, since, until time.Time) ([]polledEvent, bool, error) {
+	params := p.params
+	params.CreatedAt = CreatedSince(since.UTC())
+	if !until.IsZero() {
+		params.CreatedAt = CreatedBetween(since.UTC(), until.UTC())
+	}
+
+	var events []polledEvent
+	complete := false
+	for page := 0; page < p.maxPages; page++ {
+		res, err := p.events.List(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, e := range res.Events {
+			if e.CreatedAt == nil {
+				return nil, false, errors.New("event " + e.Id + " has no creation time")
+			}
+			events = append(events, polledEvent{event: e, createdAt: e.CreatedAt.Time})
+		}
+
+		if res.Meta.Cursors == nil || res.Meta.Cursors.After == "" {
+			complete = true
+			break
+		}
+		params.After = res.Meta.Cursors.After
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].createdAt.Equal(events[j].createdAt) {
+			return events[i].createdAt.Before(events[j].createdAt)
+		}
+		return events[i].event.Id < events[j].event.Id
+	})
+	return events, complete, nil
+}
@@ -0,0 +1,223 @@
+package gocardless
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// fakeEventService lists its events newest first, two per page.
+type fakeEventService struct {
+	EventService
+	events []Event
+	params []EventListParams
+}
+
+func (s *fakeEventService) List(ctx context.Context, p EventListParams, opts ...RequestOption) (*EventListResult, error) {
+	s.params = append(s.params, p)
+	gte := p.CreatedAt.Gte.Time
+
+	var matching []Event
+	for i := len(s.events) - 1; i >= 0; i-- {
+		if p.CreatedAt.Lt != nil && !s.events[i].CreatedAt.Before(p.CreatedAt.Lt.Time) {
+			continue
+		}
+		if !s.events[i].CreatedAt.Before(gte) {
+			matching = append(matching, s.events[i])
+		}
+	}
+
+	start := 0
+	if p.After != "" {
+		for i, e := range matching {
+			if e.Id == p.After {
+				start = i + 1
+			}
+		}
+	}
+	end := start + 2
+	if end > len(matching) {
+		end = len(matching)
+	}
+	res := &EventListResult{Events: matching[start:end]}
+	res.Meta.Cursors = &EventListResultMetaCursors{}
+	if end < len(matching) {
+		res.Meta.Cursors.After = matching[end-1].Id
+	}
+	return res, nil
+}
+
+func pollerEvent(id string, t time.Time) Event {
+	return Event{Id: id, CreatedAt: NewTimestamp(t.UTC())}
+}
+
+func TestEventPollerDeliversEachEventOnceInOrder(t *testing.T) {
+	ctx := context.TODO()
+	start := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc := &fakeEventService{events: []Event{
+		pollerEvent("EV1", start.Add(time.Second)),
+		pollerEvent("EV2", start.Add(2*time.Second)),
+		pollerEvent("EV3", start.Add(3*time.Second)),
+	}}
+
+	var delivered []string
+	store := NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoint.json"))
+	p, err := NewEventPoller(svc, EventHandlerFunc(func(e Event) error {
+		delivered = append(delivered, e.Id)
+		return nil
+	}), WithCheckpointStore(store), WithPollStart(start), WithPollFilter(EventListParams{ResourceType: ResourcePayments}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// EV4 becomes visible late, with a creation time before the watermark.
+	svc.events = append(svc.events,
+		pollerEvent("EV4", start.Add(2500*time.Millisecond)),
+		pollerEvent("EV5", start.Add(4*time.Second)),
+	)
+	if err := p.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"EV1", "EV2", "EV3", "EV4", "EV5"}
+	if len(delivered) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, delivered)
+	}
+	for i := range expected {
+		if delivered[i] != expected[i] {
+			t.Fatalf("Expected %v, got %v", expected, delivered)
+		}
+	}
+
+	for _, params := range svc.params {
+		if params.ResourceType != ResourcePayments {
+			t.Fatalf("Expected filter to be applied, got %+v", params)
+		}
+	}
+
+	checkpoint, err := store.Load(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !checkpoint.Watermark.Equal(start.Add(4 * time.Second)) {
+		t.Fatalf("Expected watermark %v, got %v", start.Add(4*time.Second), checkpoint.Watermark)
+	}
+}
+
+func TestEventPollerRetriesFailedEvent(t *testing.T) {
+	ctx := context.TODO()
+	start := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc := &fakeEventService{events: []Event{
+		pollerEvent("EV1", start.Add(time.Second)),
+		pollerEvent("EV2", start.Add(2*time.Second)),
+	}}
+
+	calls := map[string]int{}
+	p, err := NewEventPoller(svc, EventHandlerFunc(func(e Event) error {
+		calls[e.Id]++
+		if e.Id == "EV2" && calls[e.Id] == 1 {
+			return errors.New("failed")
+		}
+		return nil
+	}), WithPollStart(start))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Poll(ctx); err == nil {
+		t.Fatal("Expected error")
+	}
+	if err := p.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if calls["EV1"] != 1 || calls["EV2"] != 2 {
+		t.Fatalf("Expected EV1 once and EV2 twice, got %v", calls)
+	}
+}
+
+func TestEventPollerBoundsPagesPerListing(t *testing.T) {
+	ctx := context.TODO()
+	start := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc := &fakeEventService{}
+	var expected []string
+	for i := 1; i <= 9; i++ {
+		id := fmt.Sprintf("EV%d", i)
+		svc.events = append(svc.events, pollerEvent(id, start.Add(time.Duration(i)*time.Second)))
+		expected = append(expected, id)
+	}
+
+	var delivered []string
+	p, err := NewEventPoller(svc, EventHandlerFunc(func(e Event) error {
+		delivered = append(delivered, e.Id)
+		return nil
+	}), WithPollStart(start), WithPollMaxPages(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if fmt.Sprint(delivered) != fmt.Sprint(expected) {
+		t.Fatalf("Expected %v, got %v", expected, delivered)
+	}
+
+	pages := 0
+	for _, params := range svc.params {
+		if params.After == "" {
+			pages = 0
+		}
+		pages++
+		if pages > 2 {
+			t.Fatalf("Expected at most 2 pages per listing, got %d", pages)
+		}
+	}
+}
+
+func TestEventPollerForgetsEventsBeforeOverlap(t *testing.T) {
+	ctx := context.TODO()
+	start := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc := &fakeEventService{events: []Event{
+		pollerEvent("EV3", start.Add(2*time.Minute)),
+	}}
+
+	store := &MemoryCheckpointStore{}
+	store.Save(ctx, &PollerCheckpoint{
+		Watermark: start,
+		Seen: map[string]time.Time{
+			"EV1": start.Add(-2 * time.Minute),
+			"EV2": start,
+		},
+	})
+
+	p, err := NewEventPoller(svc, EventHandlerFunc(func(e Event) error {
+		return nil
+	}), WithCheckpointStore(store), WithPollOverlap(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	checkpoint, err := store.Load(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(checkpoint.Seen) != 1 {
+		t.Fatalf("Expected only EV3 to be kept, got %v", checkpoint.Seen)
+	}
+	if _, ok := checkpoint.Seen["EV3"]; !ok {
+		t.Fatalf("Expected only EV3 to be kept, got %v", checkpoint.Seen)
+	}
+}
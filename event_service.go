@@ -24,58 +24,63 @@ type EventServiceImpl struct {
 }
 
 type EventCustomerNotifications struct {
-	Deadline  string `url:"deadline,omitempty" json:"deadline,omitempty"`
-	Id        string `url:"id,omitempty" json:"id,omitempty"`
-	Mandatory bool   `url:"mandatory,omitempty" json:"mandatory,omitempty"`
-	Type      string `url:"type,omitempty" json:"type,omitempty"`
+	Deadline  *Timestamp                 `url:"deadline,omitempty" json:"deadline,omitempty"`
+	Id        string                     `url:"id,omitempty" json:"id,omitempty"`
+	Mandatory bool                       `url:"mandatory,omitempty" json:"mandatory,omitempty"`
+	Type      string                     `url:"type,omitempty" json:"type,omitempty"`
+	Extra     map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type EventDetails struct {
-	BankAccountId    string `url:"bank_account_id,omitempty" json:"bank_account_id,omitempty"`
-	Cause            string `url:"cause,omitempty" json:"cause,omitempty"`
-	Currency         string `url:"currency,omitempty" json:"currency,omitempty"`
-	Description      string `url:"description,omitempty" json:"description,omitempty"`
-	NotRetriedReason string `url:"not_retried_reason,omitempty" json:"not_retried_reason,omitempty"`
-	Origin           string `url:"origin,omitempty" json:"origin,omitempty"`
-	Property         string `url:"property,omitempty" json:"property,omitempty"`
-	ReasonCode       string `url:"reason_code,omitempty" json:"reason_code,omitempty"`
-	Scheme           string `url:"scheme,omitempty" json:"scheme,omitempty"`
-	WillAttemptRetry bool   `url:"will_attempt_retry,omitempty" json:"will_attempt_retry,omitempty"`
+	BankAccountId    string                     `url:"bank_account_id,omitempty" json:"bank_account_id,omitempty"`
+	Cause            string                     `url:"cause,omitempty" json:"cause,omitempty"`
+	Currency         string                     `url:"currency,omitempty" json:"currency,omitempty"`
+	Description      string                     `url:"description,omitempty" json:"description,omitempty"`
+	NotRetriedReason string                     `url:"not_retried_reason,omitempty" json:"not_retried_reason,omitempty"`
+	Origin           string                     `url:"origin,omitempty" json:"origin,omitempty"`
+	Property         string                     `url:"property,omitempty" json:"property,omitempty"`
+	ReasonCode       string                     `url:"reason_code,omitempty" json:"reason_code,omitempty"`
+	Scheme           Scheme                     `url:"scheme,omitempty" json:"scheme,omitempty"`
+	WillAttemptRetry bool                       `url:"will_attempt_retry,omitempty" json:"will_attempt_retry,omitempty"`
+	Extra            map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type EventLinks struct {
-	BankAuthorisation           string `url:"bank_authorisation,omitempty" json:"bank_authorisation,omitempty"`
-	BillingRequest              string `url:"billing_request,omitempty" json:"billing_request,omitempty"`
-	BillingRequestFlow          string `url:"billing_request_flow,omitempty" json:"billing_request_flow,omitempty"`
-	Creditor                    string `url:"creditor,omitempty" json:"creditor,omitempty"`
-	Customer                    string `url:"customer,omitempty" json:"customer,omitempty"`
-	CustomerBankAccount         string `url:"customer_bank_account,omitempty" json:"customer_bank_account,omitempty"`
-	InstalmentSchedule          string `url:"instalment_schedule,omitempty" json:"instalment_schedule,omitempty"`
-	Mandate                     string `url:"mandate,omitempty" json:"mandate,omitempty"`
-	MandateRequestMandate       string `url:"mandate_request_mandate,omitempty" json:"mandate_request_mandate,omitempty"`
-	NewCustomerBankAccount      string `url:"new_customer_bank_account,omitempty" json:"new_customer_bank_account,omitempty"`
-	NewMandate                  string `url:"new_mandate,omitempty" json:"new_mandate,omitempty"`
-	Organisation                string `url:"organisation,omitempty" json:"organisation,omitempty"`
-	ParentEvent                 string `url:"parent_event,omitempty" json:"parent_event,omitempty"`
-	PayerAuthorisation          string `url:"payer_authorisation,omitempty" json:"payer_authorisation,omitempty"`
-	Payment                     string `url:"payment,omitempty" json:"payment,omitempty"`
-	PaymentRequestPayment       string `url:"payment_request_payment,omitempty" json:"payment_request_payment,omitempty"`
-	Payout                      string `url:"payout,omitempty" json:"payout,omitempty"`
-	PreviousCustomerBankAccount string `url:"previous_customer_bank_account,omitempty" json:"previous_customer_bank_account,omitempty"`
-	Refund                      string `url:"refund,omitempty" json:"refund,omitempty"`
-	Subscription                string `url:"subscription,omitempty" json:"subscription,omitempty"`
+	BankAuthorisation           string                     `url:"bank_authorisation,omitempty" json:"bank_authorisation,omitempty"`
+	BillingRequest              string                     `url:"billing_request,omitempty" json:"billing_request,omitempty"`
+	BillingRequestFlow          string                     `url:"billing_request_flow,omitempty" json:"billing_request_flow,omitempty"`
+	Creditor                    string                     `url:"creditor,omitempty" json:"creditor,omitempty"`
+	Customer                    string                     `url:"customer,omitempty" json:"customer,omitempty"`
+	CustomerBankAccount         string                     `url:"customer_bank_account,omitempty" json:"customer_bank_account,omitempty"`
+	InstalmentSchedule          string                     `url:"instalment_schedule,omitempty" json:"instalment_schedule,omitempty"`
+	Mandate                     string                     `url:"mandate,omitempty" json:"mandate,omitempty"`
+	MandateRequestMandate       string                     `url:"mandate_request_mandate,omitempty" json:"mandate_request_mandate,omitempty"`
+	NewCustomerBankAccount      string                     `url:"new_customer_bank_account,omitempty" json:"new_customer_bank_account,omitempty"`
+	NewMandate                  string                     `url:"new_mandate,omitempty" json:"new_mandate,omitempty"`
+	Organisation                string                     `url:"organisation,omitempty" json:"organisation,omitempty"`
+	ParentEvent                 string                     `url:"parent_event,omitempty" json:"parent_event,omitempty"`
+	PayerAuthorisation          string                     `url:"payer_authorisation,omitempty" json:"payer_authorisation,omitempty"`
+	Payment                     string                     `url:"payment,omitempty" json:"payment,omitempty"`
+	PaymentRequestPayment       string                     `url:"payment_request_payment,omitempty" json:"payment_request_payment,omitempty"`
+	Payout                      string                     `url:"payout,omitempty" json:"payout,omitempty"`
+	PreviousCustomerBankAccount string                     `url:"previous_customer_bank_account,omitempty" json:"previous_customer_bank_account,omitempty"`
+	Refund                      string                     `url:"refund,omitempty" json:"refund,omitempty"`
+	Subscription                string                     `url:"subscription,omitempty" json:"subscription,omitempty"`
+	Extra                       map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 // Event model
 type Event struct {
-	Action                string                       `url:"action,omitempty" json:"action,omitempty"`
-	CreatedAt             string                       `url:"created_at,omitempty" json:"created_at,omitempty"`
+	Action                EventAction                  `url:"action,omitempty" json:"action,omitempty"`
+	CreatedAt             *Timestamp                   `url:"created_at,omitempty" json:"created_at,omitempty"`
 	CustomerNotifications []EventCustomerNotifications `url:"customer_notifications,omitempty" json:"customer_notifications,omitempty"`
 	Details               *EventDetails                `url:"details,omitempty" json:"details,omitempty"`
 	Id                    string                       `url:"id,omitempty" json:"id,omitempty"`
 	Links                 *EventLinks                  `url:"links,omitempty" json:"links,omitempty"`
-	Metadata              map[string]interface{}       `url:"metadata,omitempty" json:"metadata,omitempty"`
-	ResourceType          string                       `url:"resource_type,omitempty" json:"resource_type,omitempty"`
+	Metadata              Metadata                     `url:"metadata,omitempty" json:"metadata,omitempty"`
+	ResourceType          ResourceType                 `url:"resource_type,omitempty" json:"resource_type,omitempty"`
+	Extra                 map[string]json.RawMessage   `url:"-" json:"-"`
+	raw                   json.RawMessage
 }
 
 type EventService interface {
@@ -84,16 +89,11 @@ type EventService interface {
 	Get(ctx context.Context, identity string, opts ...RequestOption) (*Event, error)
 }
 
-type EventListParamsCreatedAt struct {
-	Gt  string `url:"gt,omitempty" json:"gt,omitempty"`
-	Gte string `url:"gte,omitempty" json:"gte,omitempty"`
-	Lt  string `url:"lt,omitempty" json:"lt,omitempty"`
-	Lte string `url:"lte,omitempty" json:"lte,omitempty"`
-}
+type EventListParamsCreatedAt = TimestampRange
 
 // EventListParams parameters
 type EventListParams struct {
-	Action             string                    `url:"action,omitempty" json:"action,omitempty"`
+	Action             EventAction               `url:"action,omitempty" json:"action,omitempty"`
 	After              string                    `url:"after,omitempty" json:"after,omitempty"`
 	Before             string                    `url:"before,omitempty" json:"before,omitempty"`
 	BillingRequest     string                    `url:"billing_request,omitempty" json:"billing_request,omitempty"`
@@ -107,7 +107,7 @@ type EventListParams struct {
 	Payment            string                    `url:"payment,omitempty" json:"payment,omitempty"`
 	Payout             string                    `url:"payout,omitempty" json:"payout,omitempty"`
 	Refund             string                    `url:"refund,omitempty" json:"refund,omitempty"`
-	ResourceType       string                    `url:"resource_type,omitempty" json:"resource_type,omitempty"`
+	ResourceType       ResourceType              `url:"resource_type,omitempty" json:"resource_type,omitempty"`
 	Subscription       string                    `url:"subscription,omitempty" json:"subscription,omitempty"`
 }
 
@@ -121,9 +121,23 @@ type EventListResultMeta struct {
 	Limit   int                         `url:"limit,omitempty" json:"limit,omitempty"`
 }
 
+type EventListResultLinked struct {
+	BillingRequests     []BillingRequest     `url:"billing_requests,omitempty" json:"billing_requests,omitempty"`
+	Creditors           []Creditor           `url:"creditors,omitempty" json:"creditors,omitempty"`
+	Customers           []Customer           `url:"customers,omitempty" json:"customers,omitempty"`
+	InstalmentSchedules []InstalmentSchedule `url:"instalment_schedules,omitempty" json:"instalment_schedules,omitempty"`
+	Mandates            []Mandate            `url:"mandates,omitempty" json:"mandates,omitempty"`
+	PayerAuthorisations []PayerAuthorisation `url:"payer_authorisations,omitempty" json:"payer_authorisations,omitempty"`
+	Payments            []Payment            `url:"payments,omitempty" json:"payments,omitempty"`
+	Payouts             []Payout             `url:"payouts,omitempty" json:"payouts,omitempty"`
+	Refunds             []Refund             `url:"refunds,omitempty" json:"refunds,omitempty"`
+	Subscriptions       []Subscription       `url:"subscriptions,omitempty" json:"subscriptions,omitempty"`
+}
+
 type EventListResult struct {
-	Events []Event             `json:"events"`
-	Meta   EventListResultMeta `url:"meta,omitempty" json:"meta,omitempty"`
+	Events []Event                `json:"events"`
+	Linked *EventListResultLinked `url:"linked,omitempty" json:"linked,omitempty"`
+	Meta   EventListResultMeta    `url:"meta,omitempty" json:"meta,omitempty"`
 }
 
 // List
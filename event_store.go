@@ -0,0 +1,191 @@
+package gocardless
+
+import (
+	"bufio"
+	"container/list"
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+)
+
+// EventStore records the IDs of the events which have been processed, so
+// that events delivered more than once are only handled once.
+type EventStore interface {
+	// Processed reports whether the event has already been processed.
+	Processed(ctx context.Context, id string) (bool, error)
+	// MarkProcessed records the event as processed.
+	MarkProcessed(ctx context.Context, id string) error
+}
+
+// MemoryEventStore is an EventStore keeping the most recently processed
+// event IDs in memory. It is safe for concurrent use.
+type MemoryEventStore struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	ids      map[string]*list.Element
+}
+
+// NewMemoryEventStore returns a MemoryEventStore remembering up to capacity
+// events, evicting the least recently seen ones first.
+func NewMemoryEventStore(capacity int) *MemoryEventStore {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &MemoryEventStore{
+		capacity: capacity,
+		order:    list.New(),
+		ids:      make(map[string]*list.Element),
+	}
+}
+
+// Processed reports whether the event has already been processed.
+func (s *MemoryEventStore) Processed(ctx context.Context, id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	el, ok := s.ids[id]
+	if ok {
+		s.order.MoveToFront(el)
+	}
+	return ok, nil
+}
+
+// MarkProcessed records the event as processed.
+func (s *MemoryEventStore) MarkProcessed(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if el, ok := s.ids[id]; ok {
+		s.order.MoveToFront(el)
+		return nil
+	}
+	s.ids[id] = s.order.PushFront(id)
+	for s.order.Len() > s.capacity {
+		el := s.order.Back()
+		s.order.Remove(el)
+		delete(s.ids, el.Value.(string))
+	}
+	return nil
+}
+
+// FileEventStore is an EventStore appending processed event IDs to a file,
+// one per line, so that they survive restarts. It is safe for concurrent use
+// within a process.
+type FileEventStore struct {
+	mu  sync.Mutex
+	f   *os.File
+	ids map[string]struct{}
+}
+
+// NewFileEventStore opens or creates the file at path and loads the event
+// IDs it already holds.
+func NewFileEventStore(path string) (*FileEventStore, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]struct{})
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if id := strings.TrimSpace(scanner.Text()); id != "" {
+			ids[id] = struct{}{}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return &FileEventStore{
+		f:   f,
+		ids: ids,
+	}, nil
+}
+
+// Processed reports whether the event has already been processed.
+func (s *FileEventStore) Processed(ctx context.Context, id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.ids[id]
+	return ok, nil
+}
+
+// MarkProcessed records the event as processed and syncs the file to disk.
+func (s *FileEventStore) MarkProcessed(ctx context.Context, id string) error {
+	if id == "" || strings.ContainsAny(id, "\r\n") {
+		return errors.New("invalid event id")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.f == nil {
+		return errors.New("event store is closed")
+	}
+	if _, ok := s.ids[id]; ok {
+		return nil
+	}
+	if _, err := s.f.WriteString(id + "\n"); err != nil {
+		return err
+	}
+	if err := s.f.Sync(); err != nil {
+		return err
+	}
+	s.ids[id] = struct{}{}
+	return nil
+}
+
+// Close closes the underlying file.
+func (s *FileEventStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.f == nil {
+		return nil
+	}
+	err := s.f.Close()
+	s.f = nil
+	return err
+}
+
+// keyedMutex serialises work on the same key while letting different keys
+// proceed concurrently.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLock
+}
+
+type keyedLock struct {
+	sync.Mutex
+	refs int
+}
+
+func (m *keyedMutex) lock(key string) (unlock func()) {
+	m.mu.Lock()
+	if m.locks == nil {
+		m.locks = make(map[string]*keyedLock)
+	}
+	l, ok := m.locks[key]
+	if !ok {
+		l = &keyedLock{}
+		m.locks[key] = l
+	}
+	l.refs++
+	m.mu.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		m.mu.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(m.locks, key)
+		}
+		m.mu.Unlock()
+	}
+}
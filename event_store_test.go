@@ -0,0 +1,141 @@
+package gocardless
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemoryEventStoreEvictsLeastRecentlySeen(t *testing.T) {
+	ctx := context.TODO()
+	s := NewMemoryEventStore(2)
+
+	s.MarkProcessed(ctx, "EV1")
+	s.MarkProcessed(ctx, "EV2")
+	s.Processed(ctx, "EV1")
+	s.MarkProcessed(ctx, "EV3")
+
+	for id, expected := range map[string]bool{"EV1": true, "EV2": false, "EV3": true} {
+		got, err := s.Processed(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != expected {
+			t.Fatalf("%s: Expected %v, got %v", id, expected, got)
+		}
+	}
+}
+
+func TestFileEventStorePersistsAcrossReopen(t *testing.T) {
+	ctx := context.TODO()
+	path := filepath.Join(t.TempDir(), "events")
+
+	s, err := NewFileEventStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.MarkProcessed(ctx, "EV1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err = NewFileEventStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	if ok, _ := s.Processed(ctx, "EV1"); !ok {
+		t.Fatal("Expected EV1 to be processed")
+	}
+	if ok, _ := s.Processed(ctx, "EV2"); ok {
+		t.Fatal("Expected EV2 not to be processed")
+	}
+}
+
+func TestWebhookSkipsProcessedEvents(t *testing.T) {
+	body := []byte(`{"events":[{"id":"EV1"},{"id":"EV2"}]}`)
+	store := NewMemoryEventStore(10)
+
+	calls := map[string]int{}
+	fail := true
+	wh, err := NewWebhookHandler("testing", EventHandlerFunc(func(e Event) error {
+		calls[e.Id]++
+		if e.Id == "EV2" && fail {
+			return errors.New("failed")
+		}
+		return nil
+	}), WithEventStore(store))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	send := func() int {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/webhook", bytes.NewReader(body))
+		r.Header.Set("Webhook-Signature", signWebhookBody("testing", body))
+		wh.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	if code := send(); code != http.StatusInternalServerError {
+		t.Fatalf("Expected %d, got %d", http.StatusInternalServerError, code)
+	}
+	fail = false
+	if code := send(); code != http.StatusNoContent {
+		t.Fatalf("Expected %d, got %d", http.StatusNoContent, code)
+	}
+	if code := send(); code != http.StatusNoContent {
+		t.Fatalf("Expected %d, got %d", http.StatusNoContent, code)
+	}
+
+	if calls["EV1"] != 1 {
+		t.Fatalf("Expected EV1 to be handled once, got %d", calls["EV1"])
+	}
+	if calls["EV2"] != 2 {
+		t.Fatalf("Expected EV2 to be handled twice, got %d", calls["EV2"])
+	}
+}
+
+func TestWebhookHandlesConcurrentDeliveriesOnce(t *testing.T) {
+	body := []byte(`{"events":[{"id":"EV1"}]}`)
+
+	var calls int32
+	wh, err := NewWebhookHandler("testing", EventHandlerFunc(func(e Event) error {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	}), WithEventStore(NewMemoryEventStore(10)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "/webhook", bytes.NewReader(body))
+			r.Header.Set("Webhook-Signature", signWebhookBody("testing", body))
+			wh.ServeHTTP(w, r)
+			if w.Code != http.StatusNoContent {
+				t.Errorf("Expected %d, got %d", http.StatusNoContent, w.Code)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("Expected 1 call, got %d", calls)
+	}
+}
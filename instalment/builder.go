@@ -0,0 +1,256 @@
+// Package instalment builds the params of instalment schedules, splitting a
+// total amount into instalments which add up to it exactly.
+package instalment
+
+import (
+	"errors"
+	"fmt"
+
+	gocardless "github.com/gocardless/gocardless-pro-go/v2"
+	"github.com/gocardless/gocardless-pro-go/v2/chargedate"
+)
+
+// Remainder is where the minor units left over when splitting a total
+// equally go.
+type Remainder int
+
+const (
+	// RemainderFirst adds the remainder to the first instalments.
+	RemainderFirst Remainder = iota
+	// RemainderLast adds the remainder to the last instalments.
+	RemainderLast
+)
+
+// Builder splits a total into instalments charged at a regular interval.
+type Builder struct {
+	total        gocardless.Money
+	count        int
+	deposit      *gocardless.Money
+	remainder    Remainder
+	startDate    *gocardless.Date
+	interval     int
+	intervalUnit gocardless.IntervalUnit
+	calculator   *chargedate.Calculator
+}
+
+// BuilderOption configures a Builder.
+type BuilderOption func(*Builder) error
+
+// WithDeposit takes deposit as the first instalment, splitting the rest of
+// the total over the other instalments.
+func WithDeposit(deposit gocardless.Money) BuilderOption {
+	return func(b *Builder) error {
+		b.deposit = &deposit
+		return nil
+	}
+}
+
+// WithRemainder sets where the remainder of the split goes. It defaults to
+// RemainderFirst.
+func WithRemainder(r Remainder) BuilderOption {
+	return func(b *Builder) error {
+		if r != RemainderFirst && r != RemainderLast {
+			return errors.New("invalid remainder")
+		}
+		b.remainder = r
+		return nil
+	}
+}
+
+// WithStartDate sets the date of the first instalment. It is required.
+func WithStartDate(d gocardless.Date) BuilderOption {
+	return func(b *Builder) error {
+		b.startDate = &d
+		return nil
+	}
+}
+
+// WithInterval sets the interval between instalments. It defaults to
+// monthly.
+func WithInterval(interval int, unit gocardless.IntervalUnit) BuilderOption {
+	return func(b *Builder) error {
+		if interval < 1 {
+			return errors.New("interval must be positive")
+		}
+		if !unit.IsValid() {
+			return fmt.Errorf("invalid interval unit %q", string(unit))
+		}
+		b.interval, b.intervalUnit = interval, unit
+		return nil
+	}
+}
+
+// WithCalculator sets the calculator used to work out charge dates. It
+// defaults to one using the embedded calendars.
+func WithCalculator(c *chargedate.Calculator) BuilderOption {
+	return func(b *Builder) error {
+		if c == nil {
+			return errors.New("missing calculator")
+		}
+		b.calculator = c
+		return nil
+	}
+}
+
+// NewBuilder returns a Builder splitting total into count instalments.
+func NewBuilder(total gocardless.Money, count int, opts ...BuilderOption) (*Builder, error) {
+	b := &Builder{
+		total:        total,
+		count:        count,
+		interval:     1,
+		intervalUnit: gocardless.IntervalUnitMonthly,
+	}
+	for _, opt := range opts {
+		if err := opt(b); err != nil {
+			return nil, err
+		}
+	}
+
+	if !total.Currency.IsValid() {
+		return nil, fmt.Errorf("unsupported currency %q", string(total.Currency))
+	}
+	if total.Amount <= 0 {
+		return nil, errors.New("total must be positive")
+	}
+	if count < 1 {
+		return nil, errors.New("count must be positive")
+	}
+	if b.startDate == nil {
+		return nil, errors.New("missing start date")
+	}
+	if b.deposit != nil {
+		if b.deposit.Currency != total.Currency {
+			return nil, gocardless.ErrCurrencyMismatch
+		}
+		if count < 2 {
+			return nil, errors.New("count must be at least 2 with a deposit")
+		}
+		if b.deposit.Amount <= 0 || b.deposit.Amount >= total.Amount {
+			return nil, errors.New("deposit must be positive and less than the total")
+		}
+	}
+	if b.calculator == nil {
+		c, err := chargedate.NewCalculator()
+		if err != nil {
+			return nil, err
+		}
+		b.calculator = c
+	}
+	return b, nil
+}
+
+// Amounts returns the amount of each instalment, in minor units. They add up
+// to the total.
+func (b *Builder) Amounts() []int {
+	amounts := make([]int, 0, b.count)
+	rest, n := b.total.Amount, int64(b.count)
+	if b.deposit != nil {
+		amounts = append(amounts, int(b.deposit.Amount))
+		rest -= b.deposit.Amount
+		n--
+	}
+
+	share, remainder := rest/n, rest%n
+	for i := int64(0); i < n; i++ {
+		amount := share
+		if (b.remainder == RemainderFirst && i < remainder) || (b.remainder == RemainderLast && i >= n-remainder) {
+			amount++
+		}
+		amounts = append(amounts, int(amount))
+	}
+	return amounts
+}
+
+// ChargeDates returns the charge date of each instalment. Instalments are
+// due on the day of the month of the start date, at the interval, and are
+// rolled forward to working days of the scheme of the currency.
+func (b *Builder) ChargeDates() ([]gocardless.Date, error) {
+	payments, err := b.calculator.ProjectSchedule(gocardless.SubscriptionCreateParams{
+		Count:        b.count,
+		Currency:     string(b.total.Currency),
+		Interval:     b.interval,
+		IntervalUnit: b.intervalUnit,
+		StartDate:    b.startDate,
+	}, *b.startDate, gocardless.Date{Year: 9999, Month: 12, Day: 31})
+	if err != nil {
+		return nil, err
+	}
+	dates := make([]gocardless.Date, len(payments))
+	for i, p := range payments {
+		dates[i] = *p.ChargeDate
+	}
+	return dates, nil
+}
+
+// ChargeDateError is returned by Validate when the first instalment is due
+// before the earliest date it can be charged.
+type ChargeDateError struct {
+	ChargeDate gocardless.Date
+	Earliest   gocardless.Date
+}
+
+func (e *ChargeDateError) Error() string {
+	return fmt.Sprintf("first instalment due on %s, before the earliest charge date %s", e.ChargeDate, e.Earliest)
+}
+
+// Validate checks that the first instalment of a schedule created on today
+// can be charged on its date, given the lead time of the scheme of the
+// currency. It returns a *ChargeDateError if it can't.
+func (b *Builder) Validate(today gocardless.Date) error {
+	scheme, err := chargedate.SchemeForCurrency(b.total.Currency)
+	if err != nil {
+		return err
+	}
+	dates, err := b.ChargeDates()
+	if err != nil {
+		return err
+	}
+	earliest, err := b.calculator.EarliestChargeDate(scheme, today)
+	if err != nil {
+		return err
+	}
+	if dates[0].Before(earliest) {
+		return &ChargeDateError{ChargeDate: dates[0], Earliest: earliest}
+	}
+	return nil
+}
+
+// DatesParams returns the params to create the schedule with explicit
+// charge dates. The mandate, name and other fields are left to be set.
+func (b *Builder) DatesParams() (gocardless.InstalmentScheduleCreateWithDatesParams, error) {
+	dates, err := b.ChargeDates()
+	if err != nil {
+		return gocardless.InstalmentScheduleCreateWithDatesParams{}, err
+	}
+	amounts := b.Amounts()
+	instalments := make([]gocardless.InstalmentScheduleCreateWithDatesParamsInstalments, len(amounts))
+	for i := range amounts {
+		d := dates[i]
+		instalments[i] = gocardless.InstalmentScheduleCreateWithDatesParamsInstalments{
+			Amount:     amounts[i],
+			ChargeDate: &d,
+		}
+	}
+	return gocardless.InstalmentScheduleCreateWithDatesParams{
+		Currency:    string(b.total.Currency),
+		Instalments: instalments,
+		TotalAmount: int(b.total.Amount),
+	}, nil
+}
+
+// ScheduleParams returns the params to create the schedule from a start
+// date and interval, leaving the API to work out the charge dates. The
+// mandate, name and other fields are left to be set.
+func (b *Builder) ScheduleParams() gocardless.InstalmentScheduleCreateWithScheduleParams {
+	start := *b.startDate
+	return gocardless.InstalmentScheduleCreateWithScheduleParams{
+		Currency: string(b.total.Currency),
+		Instalments: gocardless.InstalmentScheduleCreateWithScheduleParamsInstalments{
+			Amounts:      b.Amounts(),
+			Interval:     b.interval,
+			IntervalUnit: b.intervalUnit,
+			StartDate:    &start,
+		},
+		TotalAmount: int(b.total.Amount),
+	}
+}
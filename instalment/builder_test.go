@@ -0,0 +1,115 @@
+package instalment
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	gocardless "github.com/gocardless/gocardless-pro-go/v2"
+)
+
+func TestAmounts(t *testing.T) {
+	total := gocardless.NewMoney(10000, gocardless.CurrencyGBP)
+	start := WithStartDate(gocardless.Date{Year: 2026, Month: time.November, Day: 2})
+	for _, tc := range []struct {
+		opts     []BuilderOption
+		expected string
+	}{
+		{nil, "[3334 3333 3333]"},
+		{[]BuilderOption{WithRemainder(RemainderLast)}, "[3333 3333 3334]"},
+		{[]BuilderOption{WithDeposit(gocardless.NewMoney(2500, gocardless.CurrencyGBP))}, "[2500 3750 3750]"},
+		{[]BuilderOption{WithDeposit(gocardless.NewMoney(1000, gocardless.CurrencyGBP)), WithRemainder(RemainderLast)}, "[1000 4500 4500]"},
+	} {
+		b, err := NewBuilder(total, 3, append(tc.opts, start)...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		amounts := b.Amounts()
+		if fmt.Sprint(amounts) != tc.expected {
+			t.Fatalf("Expected %s, got %v", tc.expected, amounts)
+		}
+		sum := 0
+		for _, a := range amounts {
+			sum += a
+		}
+		if sum != 10000 {
+			t.Fatalf("Expected instalments to add up to 10000, got %d", sum)
+		}
+	}
+}
+
+func TestNewBuilderErrors(t *testing.T) {
+	total := gocardless.NewMoney(10000, gocardless.CurrencyGBP)
+	start := WithStartDate(gocardless.Date{Year: 2026, Month: time.November, Day: 2})
+	for _, opts := range [][]BuilderOption{
+		nil,
+		{start, WithDeposit(gocardless.NewMoney(100, gocardless.CurrencyEUR))},
+		{start, WithDeposit(gocardless.NewMoney(10000, gocardless.CurrencyGBP))},
+		{start, WithInterval(0, gocardless.IntervalUnitWeekly)},
+	} {
+		if _, err := NewBuilder(total, 3, opts...); err == nil {
+			t.Fatal("Expected error")
+		}
+	}
+}
+
+func TestParams(t *testing.T) {
+	b, err := NewBuilder(gocardless.NewMoney(10000, gocardless.CurrencyGBP), 3,
+		WithStartDate(gocardless.Date{Year: 2026, Month: time.November, Day: 30}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	withDates, err := b.DatesParams()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 30 January 2027 is a Saturday.
+	var got []string
+	for _, i := range withDates.Instalments {
+		got = append(got, fmt.Sprintf("%d@%s", i.Amount, i.ChargeDate))
+	}
+	expected := "[3334@2026-11-30 3333@2026-12-30 3333@2027-02-01]"
+	if fmt.Sprint(got) != expected {
+		t.Fatalf("Expected %s, got %v", expected, got)
+	}
+	if withDates.TotalAmount != 10000 || withDates.Currency != "GBP" {
+		t.Fatalf("Unexpected params %+v", withDates)
+	}
+
+	withSchedule := b.ScheduleParams()
+	if fmt.Sprint(withSchedule.Instalments.Amounts) != "[3334 3333 3333]" || withSchedule.Instalments.StartDate.String() != "2026-11-30" {
+		t.Fatalf("Unexpected params %+v", withSchedule.Instalments)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	b, err := NewBuilder(gocardless.NewMoney(250, gocardless.CurrencyGBP), 3,
+		WithStartDate(gocardless.Date{Year: 2026, Month: time.October, Day: 20}),
+		WithInterval(1, gocardless.IntervalUnitWeekly),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = b.Validate(gocardless.Date{Year: 2026, Month: time.October, Day: 19})
+	var dateErr *ChargeDateError
+	if !errors.As(err, &dateErr) {
+		t.Fatalf("Expected *ChargeDateError, got %v", err)
+	}
+	expected := "first instalment due on 2026-10-20, before the earliest charge date 2026-10-22"
+	if err.Error() != expected {
+		t.Fatalf("Expected %q, got %q", expected, err.Error())
+	}
+
+	b, err = NewBuilder(gocardless.NewMoney(30000, gocardless.CurrencyGBP), 3,
+		WithStartDate(gocardless.Date{Year: 2026, Month: time.October, Day: 22}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Validate(gocardless.Date{Year: 2026, Month: time.October, Day: 19}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -24,22 +24,25 @@ type InstalmentScheduleServiceImpl struct {
 }
 
 type InstalmentScheduleLinks struct {
-	Customer string   `url:"customer,omitempty" json:"customer,omitempty"`
-	Mandate  string   `url:"mandate,omitempty" json:"mandate,omitempty"`
-	Payments []string `url:"payments,omitempty" json:"payments,omitempty"`
+	Customer string                     `url:"customer,omitempty" json:"customer,omitempty"`
+	Mandate  string                     `url:"mandate,omitempty" json:"mandate,omitempty"`
+	Payments []string                   `url:"payments,omitempty" json:"payments,omitempty"`
+	Extra    map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 // InstalmentSchedule model
 type InstalmentSchedule struct {
-	CreatedAt     string                   `url:"created_at,omitempty" json:"created_at,omitempty"`
-	Currency      string                   `url:"currency,omitempty" json:"currency,omitempty"`
-	Id            string                   `url:"id,omitempty" json:"id,omitempty"`
-	Links         *InstalmentScheduleLinks `url:"links,omitempty" json:"links,omitempty"`
-	Metadata      map[string]interface{}   `url:"metadata,omitempty" json:"metadata,omitempty"`
-	Name          string                   `url:"name,omitempty" json:"name,omitempty"`
-	PaymentErrors map[string]interface{}   `url:"payment_errors,omitempty" json:"payment_errors,omitempty"`
-	Status        string                   `url:"status,omitempty" json:"status,omitempty"`
-	TotalAmount   int                      `url:"total_amount,omitempty" json:"total_amount,omitempty"`
+	CreatedAt     *Timestamp                 `url:"created_at,omitempty" json:"created_at,omitempty"`
+	Currency      string                     `url:"currency,omitempty" json:"currency,omitempty"`
+	Id            string                     `url:"id,omitempty" json:"id,omitempty"`
+	Links         *InstalmentScheduleLinks   `url:"links,omitempty" json:"links,omitempty"`
+	Metadata      Metadata                   `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Name          string                     `url:"name,omitempty" json:"name,omitempty"`
+	PaymentErrors map[string]interface{}     `url:"payment_errors,omitempty" json:"payment_errors,omitempty"`
+	Status        InstalmentScheduleStatus   `url:"status,omitempty" json:"status,omitempty"`
+	TotalAmount   int                        `url:"total_amount,omitempty" json:"total_amount,omitempty"`
+	Extra         map[string]json.RawMessage `url:"-" json:"-"`
+	raw           json.RawMessage
 }
 
 type InstalmentScheduleService interface {
@@ -54,7 +57,7 @@ type InstalmentScheduleService interface {
 
 type InstalmentScheduleCreateWithDatesParamsInstalments struct {
 	Amount      int    `url:"amount,omitempty" json:"amount,omitempty"`
-	ChargeDate  string `url:"charge_date,omitempty" json:"charge_date,omitempty"`
+	ChargeDate  *Date  `url:"charge_date,omitempty" json:"charge_date,omitempty"`
 	Description string `url:"description,omitempty" json:"description,omitempty"`
 }
 
@@ -68,7 +71,7 @@ type InstalmentScheduleCreateWithDatesParams struct {
 	Currency         string                                               `url:"currency,omitempty" json:"currency,omitempty"`
 	Instalments      []InstalmentScheduleCreateWithDatesParamsInstalments `url:"instalments,omitempty" json:"instalments,omitempty"`
 	Links            InstalmentScheduleCreateWithDatesParamsLinks         `url:"links,omitempty" json:"links,omitempty"`
-	Metadata         map[string]interface{}                               `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata         Metadata                                             `url:"metadata,omitempty" json:"metadata,omitempty"`
 	Name             string                                               `url:"name,omitempty" json:"name,omitempty"`
 	PaymentReference string                                               `url:"payment_reference,omitempty" json:"payment_reference,omitempty"`
 	RetryIfPossible  bool                                                 `url:"retry_if_possible,omitempty" json:"retry_if_possible,omitempty"`
@@ -96,6 +99,10 @@ type InstalmentScheduleCreateWithDatesParams struct {
 // the
 // failures.
 func (s *InstalmentScheduleServiceImpl) CreateWithDates(ctx context.Context, p InstalmentScheduleCreateWithDatesParams, opts ...RequestOption) (*InstalmentSchedule, error) {
+	if err := validateParams("data", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/instalment_schedules"))
 	if err != nil {
 		return nil, err
@@ -187,10 +194,10 @@ func (s *InstalmentScheduleServiceImpl) CreateWithDates(ctx context.Context, p I
 }
 
 type InstalmentScheduleCreateWithScheduleParamsInstalments struct {
-	Amounts      []int  `url:"amounts,omitempty" json:"amounts,omitempty"`
-	Interval     int    `url:"interval,omitempty" json:"interval,omitempty"`
-	IntervalUnit string `url:"interval_unit,omitempty" json:"interval_unit,omitempty"`
-	StartDate    string `url:"start_date,omitempty" json:"start_date,omitempty"`
+	Amounts      []int        `url:"amounts,omitempty" json:"amounts,omitempty"`
+	Interval     int          `url:"interval,omitempty" json:"interval,omitempty"`
+	IntervalUnit IntervalUnit `url:"interval_unit,omitempty" json:"interval_unit,omitempty"`
+	StartDate    *Date        `url:"start_date,omitempty" json:"start_date,omitempty"`
 }
 
 type InstalmentScheduleCreateWithScheduleParamsLinks struct {
@@ -203,7 +210,7 @@ type InstalmentScheduleCreateWithScheduleParams struct {
 	Currency         string                                                `url:"currency,omitempty" json:"currency,omitempty"`
 	Instalments      InstalmentScheduleCreateWithScheduleParamsInstalments `url:"instalments,omitempty" json:"instalments,omitempty"`
 	Links            InstalmentScheduleCreateWithScheduleParamsLinks       `url:"links,omitempty" json:"links,omitempty"`
-	Metadata         map[string]interface{}                                `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata         Metadata                                              `url:"metadata,omitempty" json:"metadata,omitempty"`
 	Name             string                                                `url:"name,omitempty" json:"name,omitempty"`
 	PaymentReference string                                                `url:"payment_reference,omitempty" json:"payment_reference,omitempty"`
 	RetryIfPossible  bool                                                  `url:"retry_if_possible,omitempty" json:"retry_if_possible,omitempty"`
@@ -228,6 +235,10 @@ type InstalmentScheduleCreateWithScheduleParams struct {
 // the
 // failures.
 func (s *InstalmentScheduleServiceImpl) CreateWithSchedule(ctx context.Context, p InstalmentScheduleCreateWithScheduleParams, opts ...RequestOption) (*InstalmentSchedule, error) {
+	if err := validateParams("data", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/instalment_schedules"))
 	if err != nil {
 		return nil, err
@@ -318,12 +329,7 @@ func (s *InstalmentScheduleServiceImpl) CreateWithSchedule(ctx context.Context,
 	return result.InstalmentSchedule, nil
 }
 
-type InstalmentScheduleListParamsCreatedAt struct {
-	Gt  string `url:"gt,omitempty" json:"gt,omitempty"`
-	Gte string `url:"gte,omitempty" json:"gte,omitempty"`
-	Lt  string `url:"lt,omitempty" json:"lt,omitempty"`
-	Lte string `url:"lte,omitempty" json:"lte,omitempty"`
-}
+type InstalmentScheduleListParamsCreatedAt = TimestampRange
 
 // InstalmentScheduleListParams parameters
 type InstalmentScheduleListParams struct {
@@ -333,7 +339,7 @@ type InstalmentScheduleListParams struct {
 	Customer  string                                 `url:"customer,omitempty" json:"customer,omitempty"`
 	Limit     int                                    `url:"limit,omitempty" json:"limit,omitempty"`
 	Mandate   string                                 `url:"mandate,omitempty" json:"mandate,omitempty"`
-	Status    []string                               `url:"status,omitempty" json:"status,omitempty"`
+	Status    []InstalmentScheduleStatus             `url:"status,omitempty" json:"status,omitempty"`
 }
 
 type InstalmentScheduleListResultMetaCursors struct {
@@ -640,12 +646,16 @@ func (s *InstalmentScheduleServiceImpl) Get(ctx context.Context, identity string
 
 // InstalmentScheduleUpdateParams parameters
 type InstalmentScheduleUpdateParams struct {
-	Metadata map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 // Update
 // Updates an instalment schedule. This accepts only the metadata parameter.
 func (s *InstalmentScheduleServiceImpl) Update(ctx context.Context, identity string, p InstalmentScheduleUpdateParams, opts ...RequestOption) (*InstalmentSchedule, error) {
+	if err := validateParams("instalment_schedules", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/instalment_schedules/%v",
 		identity))
 	if err != nil {
@@ -25,12 +25,14 @@ type InstitutionServiceImpl struct {
 
 // Institution model
 type Institution struct {
-	BankRedirect bool   `url:"bank_redirect,omitempty" json:"bank_redirect,omitempty"`
-	CountryCode  string `url:"country_code,omitempty" json:"country_code,omitempty"`
-	IconUrl      string `url:"icon_url,omitempty" json:"icon_url,omitempty"`
-	Id           string `url:"id,omitempty" json:"id,omitempty"`
-	LogoUrl      string `url:"logo_url,omitempty" json:"logo_url,omitempty"`
-	Name         string `url:"name,omitempty" json:"name,omitempty"`
+	BankRedirect bool                       `url:"bank_redirect,omitempty" json:"bank_redirect,omitempty"`
+	CountryCode  string                     `url:"country_code,omitempty" json:"country_code,omitempty"`
+	IconUrl      string                     `url:"icon_url,omitempty" json:"icon_url,omitempty"`
+	Id           string                     `url:"id,omitempty" json:"id,omitempty"`
+	LogoUrl      string                     `url:"logo_url,omitempty" json:"logo_url,omitempty"`
+	Name         string                     `url:"name,omitempty" json:"name,omitempty"`
+	Extra        map[string]json.RawMessage `url:"-" json:"-"`
+	raw          json.RawMessage
 }
 
 type InstitutionService interface {
@@ -0,0 +1,147 @@
+package lifecycle
+
+import (
+	"context"
+
+	gocardless "github.com/gocardless/gocardless-pro-go/v2"
+)
+
+// FailFast returns a copy of s whose status-dependent actions check the
+// status of the resource before calling the API, failing locally with a
+// *StateError when the action isn't allowed. Each check fetches the resource
+// first, costing one extra request per action.
+func FailFast(s *gocardless.Service) *gocardless.Service {
+	guarded := *s
+	guarded.Payments = &paymentService{s.Payments}
+	guarded.Mandates = &mandateService{s.Mandates}
+	guarded.Subscriptions = &subscriptionService{s.Subscriptions}
+	guarded.InstalmentSchedules = &instalmentScheduleService{s.InstalmentSchedules}
+	guarded.BillingRequests = &billingRequestService{s.BillingRequests}
+	return &guarded
+}
+
+type paymentService struct {
+	gocardless.PaymentService
+}
+
+func (s *paymentService) check(ctx context.Context, action Action, identity string) error {
+	p, err := s.Get(ctx, identity)
+	if err != nil {
+		return err
+	}
+	return Check(action, p)
+}
+
+func (s *paymentService) Cancel(ctx context.Context, identity string, p gocardless.PaymentCancelParams, opts ...gocardless.RequestOption) (*gocardless.Payment, error) {
+	if err := s.check(ctx, Cancel, identity); err != nil {
+		return nil, err
+	}
+	return s.PaymentService.Cancel(ctx, identity, p, opts...)
+}
+
+func (s *paymentService) Retry(ctx context.Context, identity string, p gocardless.PaymentRetryParams, opts ...gocardless.RequestOption) (*gocardless.Payment, error) {
+	if err := s.check(ctx, Retry, identity); err != nil {
+		return nil, err
+	}
+	return s.PaymentService.Retry(ctx, identity, p, opts...)
+}
+
+type mandateService struct {
+	gocardless.MandateService
+}
+
+func (s *mandateService) check(ctx context.Context, action Action, identity string) error {
+	m, err := s.Get(ctx, identity)
+	if err != nil {
+		return err
+	}
+	return Check(action, m)
+}
+
+func (s *mandateService) Cancel(ctx context.Context, identity string, p gocardless.MandateCancelParams, opts ...gocardless.RequestOption) (*gocardless.Mandate, error) {
+	if err := s.check(ctx, Cancel, identity); err != nil {
+		return nil, err
+	}
+	return s.MandateService.Cancel(ctx, identity, p, opts...)
+}
+
+func (s *mandateService) Reinstate(ctx context.Context, identity string, p gocardless.MandateReinstateParams, opts ...gocardless.RequestOption) (*gocardless.Mandate, error) {
+	if err := s.check(ctx, Reinstate, identity); err != nil {
+		return nil, err
+	}
+	return s.MandateService.Reinstate(ctx, identity, p, opts...)
+}
+
+type subscriptionService struct {
+	gocardless.SubscriptionService
+}
+
+func (s *subscriptionService) check(ctx context.Context, action Action, identity string) error {
+	sub, err := s.Get(ctx, identity)
+	if err != nil {
+		return err
+	}
+	return Check(action, sub)
+}
+
+func (s *subscriptionService) Pause(ctx context.Context, identity string, p gocardless.SubscriptionPauseParams, opts ...gocardless.RequestOption) (*gocardless.Subscription, error) {
+	if err := s.check(ctx, Pause, identity); err != nil {
+		return nil, err
+	}
+	return s.SubscriptionService.Pause(ctx, identity, p, opts...)
+}
+
+func (s *subscriptionService) Resume(ctx context.Context, identity string, p gocardless.SubscriptionResumeParams, opts ...gocardless.RequestOption) (*gocardless.Subscription, error) {
+	if err := s.check(ctx, Resume, identity); err != nil {
+		return nil, err
+	}
+	return s.SubscriptionService.Resume(ctx, identity, p, opts...)
+}
+
+func (s *subscriptionService) Cancel(ctx context.Context, identity string, p gocardless.SubscriptionCancelParams, opts ...gocardless.RequestOption) (*gocardless.Subscription, error) {
+	if err := s.check(ctx, Cancel, identity); err != nil {
+		return nil, err
+	}
+	return s.SubscriptionService.Cancel(ctx, identity, p, opts...)
+}
+
+type instalmentScheduleService struct {
+	gocardless.InstalmentScheduleService
+}
+
+func (s *instalmentScheduleService) Cancel(ctx context.Context, identity string, p gocardless.InstalmentScheduleCancelParams, opts ...gocardless.RequestOption) (*gocardless.InstalmentSchedule, error) {
+	is, err := s.Get(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+	if err := Check(Cancel, is); err != nil {
+		return nil, err
+	}
+	return s.InstalmentScheduleService.Cancel(ctx, identity, p, opts...)
+}
+
+type billingRequestService struct {
+	gocardless.BillingRequestService
+}
+
+func (s *billingRequestService) check(ctx context.Context, action Action, identity string) error {
+	br, err := s.Get(ctx, identity)
+	if err != nil {
+		return err
+	}
+	return Check(action, br)
+}
+
+func (s *billingRequestService) Fulfil(ctx context.Context, identity string, p gocardless.BillingRequestFulfilParams, opts ...gocardless.RequestOption) (*gocardless.BillingRequest, error) {
+	if err := s.check(ctx, Fulfil, identity); err != nil {
+		return nil, err
+	}
+	return s.BillingRequestService.Fulfil(ctx, identity, p, opts...)
+}
+
+func (s *billingRequestService) Cancel(ctx context.Context, identity string, p gocardless.BillingRequestCancelParams, opts ...gocardless.RequestOption) (*gocardless.BillingRequest, error) {
+	if err := s.check(ctx, Cancel, identity); err != nil {
+		return nil, err
+	}
+	return s.BillingRequestService.Cancel(ctx, identity, p, opts...)
+}
@@ -0,0 +1,46 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gocardless "github.com/gocardless/gocardless-pro-go/v2"
+)
+
+type fakePaymentService struct {
+	gocardless.PaymentService
+	payment   *gocardless.Payment
+	cancelled bool
+}
+
+func (s *fakePaymentService) Get(ctx context.Context, identity string, opts ...gocardless.RequestOption) (*gocardless.Payment, error) {
+	return s.payment, nil
+}
+
+func (s *fakePaymentService) Cancel(ctx context.Context, identity string, p gocardless.PaymentCancelParams, opts ...gocardless.RequestOption) (*gocardless.Payment, error) {
+	s.cancelled = true
+	return s.payment, nil
+}
+
+func TestFailFast(t *testing.T) {
+	payments := &fakePaymentService{payment: &gocardless.Payment{Id: "PM123", Status: gocardless.PaymentStatusSubmitted}}
+	s := FailFast(&gocardless.Service{Payments: payments})
+
+	_, err := s.Payments.Cancel(context.TODO(), "PM123", gocardless.PaymentCancelParams{})
+	var stateErr *StateError
+	if !errors.As(err, &stateErr) {
+		t.Fatalf("Expected *StateError, got %v", err)
+	}
+	if payments.cancelled {
+		t.Fatal("Expected the API not to be called")
+	}
+
+	payments.payment.Status = gocardless.PaymentStatusPendingSubmission
+	if _, err := s.Payments.Cancel(context.TODO(), "PM123", gocardless.PaymentCancelParams{}); err != nil {
+		t.Fatal(err)
+	}
+	if !payments.cancelled {
+		t.Fatal("Expected the API to be called")
+	}
+}
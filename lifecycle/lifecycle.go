@@ -0,0 +1,395 @@
+// Package lifecycle encodes the statuses GoCardless resources move through
+// and the statuses in which each action is allowed, so that actions bound to
+// fail with an invalid state error can be caught without calling the API.
+package lifecycle
+
+import (
+	"fmt"
+	"strings"
+
+	gocardless "github.com/gocardless/gocardless-pro-go/v2"
+)
+
+// Action is an API action which is only allowed in some statuses.
+type Action string
+
+const (
+	Cancel    Action = "cancel"
+	Retry     Action = "retry"
+	Reinstate Action = "reinstate"
+	Pause     Action = "pause"
+	Resume    Action = "resume"
+	Fulfil    Action = "fulfil"
+)
+
+// Machine is the lifecycle of a resource type: its statuses, the transitions
+// between them and the statuses each action is allowed in.
+type Machine struct {
+	// Resource is the name of the resource type, e.g. "payment".
+	Resource string
+
+	statuses    []string
+	transitions map[string][]string
+	actions     map[Action][]string
+}
+
+// Statuses returns the documented statuses of the resource type.
+func (m *Machine) Statuses() []string {
+	return append([]string(nil), m.statuses...)
+}
+
+// Known reports whether status is documented. Statuses added to the API
+// after this library was released are unknown.
+func (m *Machine) Known(status string) bool {
+	return contains(m.statuses, status)
+}
+
+// Next returns the statuses a resource in status can move to. It is empty
+// for final statuses.
+func (m *Machine) Next(status string) []string {
+	return append([]string(nil), m.transitions[status]...)
+}
+
+// CanTransition reports whether a resource can move from one status to the
+// other.
+func (m *Machine) CanTransition(from, to string) bool {
+	return contains(m.transitions[from], to)
+}
+
+// Allowed returns the statuses in which action is allowed. It is empty if
+// the resource type doesn't support action.
+func (m *Machine) Allowed(action Action) []string {
+	return append([]string(nil), m.actions[action]...)
+}
+
+// Allows reports whether action is allowed in status.
+func (m *Machine) Allows(action Action, status string) bool {
+	return contains(m.actions[action], status)
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// into returns the statuses of list which can move to status.
+func into(list []string, transitions map[string][]string, status string) []string {
+	var out []string
+	for _, s := range list {
+		if contains(transitions[s], status) {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+var (
+	paymentStatuses = []string{
+		string(gocardless.PaymentStatusPendingCustomerApproval),
+		string(gocardless.PaymentStatusPendingSubmission),
+		string(gocardless.PaymentStatusSubmitted),
+		string(gocardless.PaymentStatusConfirmed),
+		string(gocardless.PaymentStatusPaidOut),
+		string(gocardless.PaymentStatusCancelled),
+		string(gocardless.PaymentStatusCustomerApprovalDenied),
+		string(gocardless.PaymentStatusFailed),
+		string(gocardless.PaymentStatusChargedBack),
+	}
+	mandateStatuses = []string{
+		string(gocardless.MandateStatusPendingCustomerApproval),
+		string(gocardless.MandateStatusPendingSubmission),
+		string(gocardless.MandateStatusSubmitted),
+		string(gocardless.MandateStatusActive),
+		string(gocardless.MandateStatusSuspendedByPayer),
+		string(gocardless.MandateStatusFailed),
+		string(gocardless.MandateStatusCancelled),
+		string(gocardless.MandateStatusExpired),
+		string(gocardless.MandateStatusConsumed),
+		string(gocardless.MandateStatusBlocked),
+	}
+	subscriptionStatuses = []string{
+		string(gocardless.SubscriptionStatusPendingCustomerApproval),
+		string(gocardless.SubscriptionStatusCustomerApprovalDenied),
+		string(gocardless.SubscriptionStatusActive),
+		string(gocardless.SubscriptionStatusFinished),
+		string(gocardless.SubscriptionStatusCancelled),
+		string(gocardless.SubscriptionStatusPaused),
+	}
+	instalmentScheduleStatuses = []string{
+		string(gocardless.InstalmentScheduleStatusPending),
+		string(gocardless.InstalmentScheduleStatusActive),
+		string(gocardless.InstalmentScheduleStatusCreationFailed),
+		string(gocardless.InstalmentScheduleStatusCompleted),
+		string(gocardless.InstalmentScheduleStatusCancelled),
+		string(gocardless.InstalmentScheduleStatusErrored),
+	}
+
+	mandateTransitions = map[string][]string{
+		"pending_customer_approval": {"pending_submission", "cancelled"},
+		"pending_submission":        {"submitted", "active", "failed", "cancelled", "blocked"},
+		"submitted":                 {"active", "failed", "cancelled"},
+		"active":                    {"suspended_by_payer", "cancelled", "expired", "consumed"},
+		"suspended_by_payer":        {"active", "cancelled"},
+		"cancelled":                 {"pending_submission"},
+		"expired":                   {"pending_submission"},
+	}
+	subscriptionTransitions = map[string][]string{
+		"pending_customer_approval": {"active", "customer_approval_denied", "cancelled"},
+		"active":                    {"paused", "finished", "cancelled"},
+		"paused":                    {"active", "finished", "cancelled"},
+	}
+	instalmentScheduleTransitions = map[string][]string{
+		"pending": {"active", "creation_failed"},
+		"active":  {"completed", "cancelled", "errored"},
+	}
+)
+
+// Payments is the lifecycle of payments.
+var Payments = &Machine{
+	Resource: "payment",
+	statuses: paymentStatuses,
+	transitions: map[string][]string{
+		"pending_customer_approval": {"pending_submission", "customer_approval_denied", "cancelled"},
+		"pending_submission":        {"submitted", "cancelled"},
+		"submitted":                 {"confirmed", "failed"},
+		"confirmed":                 {"paid_out", "charged_back", "failed"},
+		"paid_out":                  {"charged_back", "failed"},
+		"failed":                    {"pending_submission", "submitted"},
+		"charged_back":              {"paid_out"},
+	},
+	actions: map[Action][]string{
+		Cancel: {"pending_submission"},
+		Retry:  {"failed"},
+	},
+}
+
+// Mandates is the lifecycle of mandates.
+var Mandates = &Machine{
+	Resource:    "mandate",
+	statuses:    mandateStatuses,
+	transitions: mandateTransitions,
+	actions: map[Action][]string{
+		Cancel:    into(mandateStatuses, mandateTransitions, "cancelled"),
+		Reinstate: {"cancelled", "expired"},
+	},
+}
+
+// Subscriptions is the lifecycle of subscriptions.
+var Subscriptions = &Machine{
+	Resource:    "subscription",
+	statuses:    subscriptionStatuses,
+	transitions: subscriptionTransitions,
+	actions: map[Action][]string{
+		Pause:  {"active"},
+		Resume: {"paused"},
+		Cancel: into(subscriptionStatuses, subscriptionTransitions, "cancelled"),
+	},
+}
+
+// InstalmentSchedules is the lifecycle of instalment schedules.
+var InstalmentSchedules = &Machine{
+	Resource:    "instalment schedule",
+	statuses:    instalmentScheduleStatuses,
+	transitions: instalmentScheduleTransitions,
+	actions: map[Action][]string{
+		Cancel: into(instalmentScheduleStatuses, instalmentScheduleTransitions, "cancelled"),
+	},
+}
+
+// Refunds is the lifecycle of refunds.
+var Refunds = &Machine{
+	Resource: "refund",
+	statuses: []string{
+		string(gocardless.RefundStatusCreated),
+		string(gocardless.RefundStatusPendingSubmission),
+		string(gocardless.RefundStatusSubmitted),
+		string(gocardless.RefundStatusPaid),
+		string(gocardless.RefundStatusCancelled),
+		string(gocardless.RefundStatusBounced),
+		string(gocardless.RefundStatusFundsReturned),
+	},
+	transitions: map[string][]string{
+		"created":            {"pending_submission", "cancelled"},
+		"pending_submission": {"submitted", "cancelled"},
+		"submitted":          {"paid", "bounced"},
+		"paid":               {"funds_returned", "bounced"},
+	},
+}
+
+// Payouts is the lifecycle of payouts.
+var Payouts = &Machine{
+	Resource: "payout",
+	statuses: []string{
+		string(gocardless.PayoutStatusPending),
+		string(gocardless.PayoutStatusPaid),
+		string(gocardless.PayoutStatusBounced),
+	},
+	transitions: map[string][]string{
+		"pending": {"paid", "bounced"},
+	},
+}
+
+// BillingRequests is the lifecycle of billing requests.
+var BillingRequests = &Machine{
+	Resource: "billing request",
+	statuses: []string{
+		string(gocardless.BillingRequestStatusPending),
+		string(gocardless.BillingRequestStatusReadyToFulfil),
+		string(gocardless.BillingRequestStatusFulfilling),
+		string(gocardless.BillingRequestStatusFulfilled),
+		string(gocardless.BillingRequestStatusCancelled),
+	},
+	transitions: map[string][]string{
+		"pending":         {"ready_to_fulfil", "cancelled"},
+		"ready_to_fulfil": {"pending", "fulfilling", "fulfilled", "cancelled"},
+		"fulfilling":      {"fulfilled"},
+	},
+	actions: map[Action][]string{
+		Cancel: {"pending", "ready_to_fulfil"},
+		Fulfil: {"ready_to_fulfil"},
+	},
+}
+
+// StateError is returned when an action isn't allowed in the status of a
+// resource.
+type StateError struct {
+	Resource string
+	ID       string
+	Action   Action
+	Status   string
+	// Allowed holds the statuses in which the action is allowed.
+	Allowed []string
+	// Reason explains why the action isn't allowed when the status alone
+	// doesn't.
+	Reason string
+}
+
+func (e *StateError) Error() string {
+	prefix := fmt.Sprintf("cannot %s %s %s", e.Action, e.Resource, e.ID)
+	switch {
+	case e.Reason != "":
+		return prefix + ": " + e.Reason
+	case len(e.Allowed) == 0:
+		return fmt.Sprintf("%s: %ss don't support %s", prefix, e.Resource, e.Action)
+	}
+	return fmt.Sprintf("%s in status %q, only allowed in %s", prefix, e.Status, strings.Join(e.Allowed, ", "))
+}
+
+// Check returns a *StateError if action isn't allowed for resource, which is
+// a payment, mandate, subscription, instalment schedule, refund, payout or
+// billing request, or a pointer to one. Statuses unknown to this package are
+// let through, leaving the API to decide.
+func Check(action Action, resource interface{}) error {
+	var (
+		m          *Machine
+		id, status string
+		reason     string
+	)
+	switch r := deref(resource).(type) {
+	case gocardless.Payment:
+		m, id, status = Payments, r.Id, string(r.Status)
+	case gocardless.Mandate:
+		m, id, status = Mandates, r.Id, string(r.Status)
+	case gocardless.Subscription:
+		m, id, status = Subscriptions, r.Id, string(r.Status)
+		if action == Pause && r.Count == 0 && r.EndDate != nil && r.Status != gocardless.SubscriptionStatusPaused {
+			reason = "only subscriptions created with a count or without an end date can be paused"
+		}
+	case gocardless.InstalmentSchedule:
+		m, id, status = InstalmentSchedules, r.Id, string(r.Status)
+	case gocardless.Refund:
+		m, id, status = Refunds, r.Id, string(r.Status)
+	case gocardless.Payout:
+		m, id, status = Payouts, r.Id, string(r.Status)
+	case gocardless.BillingRequest:
+		m, id, status = BillingRequests, r.Id, string(r.Status)
+	default:
+		return fmt.Errorf("unsupported resource type %T", resource)
+	}
+
+	allowed := m.actions[action]
+	switch {
+	case len(allowed) == 0 || (m.Known(status) && !contains(allowed, status)):
+		reason = ""
+	case !m.Known(status) || reason == "":
+		return nil
+	}
+	return &StateError{
+		Resource: m.Resource,
+		ID:       id,
+		Action:   action,
+		Status:   status,
+		Allowed:  m.Allowed(action),
+		Reason:   reason,
+	}
+}
+
+// deref returns the resource a pointer points to.
+func deref(resource interface{}) interface{} {
+	switch r := resource.(type) {
+	case *gocardless.Payment:
+		if r != nil {
+			return *r
+		}
+	case *gocardless.Mandate:
+		if r != nil {
+			return *r
+		}
+	case *gocardless.Subscription:
+		if r != nil {
+			return *r
+		}
+	case *gocardless.InstalmentSchedule:
+		if r != nil {
+			return *r
+		}
+	case *gocardless.Refund:
+		if r != nil {
+			return *r
+		}
+	case *gocardless.Payout:
+		if r != nil {
+			return *r
+		}
+	case *gocardless.BillingRequest:
+		if r != nil {
+			return *r
+		}
+	}
+	return resource
+}
+
+// CanCancel reports whether resource can be cancelled.
+func CanCancel(resource interface{}) bool {
+	return Check(Cancel, resource) == nil
+}
+
+// CanRetry reports whether resource, a failed payment, can be retried.
+func CanRetry(resource interface{}) bool {
+	return Check(Retry, resource) == nil
+}
+
+// CanReinstate reports whether resource, a cancelled or expired mandate, can
+// be reinstated.
+func CanReinstate(resource interface{}) bool {
+	return Check(Reinstate, resource) == nil
+}
+
+// CanPause reports whether resource, a subscription, can be paused.
+func CanPause(resource interface{}) bool {
+	return Check(Pause, resource) == nil
+}
+
+// CanResume reports whether resource, a paused subscription, can be resumed.
+func CanResume(resource interface{}) bool {
+	return Check(Resume, resource) == nil
+}
+
+// CanFulfil reports whether resource, a billing request, can be fulfilled.
+func CanFulfil(resource interface{}) bool {
+	return Check(Fulfil, resource) == nil
+}
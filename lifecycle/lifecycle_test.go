@@ -0,0 +1,140 @@
+package lifecycle
+
+import (
+	"errors"
+	"testing"
+
+	gocardless "github.com/gocardless/gocardless-pro-go/v2"
+)
+
+func TestCheckPayment(t *testing.T) {
+	p := &gocardless.Payment{Id: "PM123", Status: gocardless.PaymentStatusPendingSubmission}
+	if !CanCancel(p) || CanRetry(p) {
+		t.Fatal("Expected pending payment to be cancellable only")
+	}
+
+	p.Status = gocardless.PaymentStatusPaidOut
+	err := Check(Cancel, p)
+	var stateErr *StateError
+	if !errors.As(err, &stateErr) {
+		t.Fatalf("Expected *StateError, got %v", err)
+	}
+	expected := `cannot cancel payment PM123 in status "paid_out", only allowed in pending_submission`
+	if err.Error() != expected {
+		t.Fatalf("Expected %q, got %q", expected, err.Error())
+	}
+	if !CanRetry(gocardless.Payment{Status: gocardless.PaymentStatusFailed}) {
+		t.Fatal("Expected failed payment to be retryable")
+	}
+}
+
+func TestCheckUnsupportedAction(t *testing.T) {
+	err := Check(Retry, &gocardless.Mandate{Id: "MD123", Status: gocardless.MandateStatusActive})
+	expected := "cannot retry mandate MD123: mandates don't support retry"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected %q, got %v", expected, err)
+	}
+	if err := Check(Cancel, "PM123"); err == nil {
+		t.Fatal("Expected error for unsupported resource")
+	}
+}
+
+func TestCheckLetsUnknownStatusesThrough(t *testing.T) {
+	if !CanCancel(&gocardless.Payment{Status: "some_new_status"}) {
+		t.Fatal("Expected unknown status to be let through")
+	}
+}
+
+func TestCheckMandate(t *testing.T) {
+	m := gocardless.Mandate{Status: gocardless.MandateStatusCancelled}
+	if CanCancel(m) || !CanReinstate(m) {
+		t.Fatal("Expected cancelled mandate to be reinstatable only")
+	}
+	m.Status = gocardless.MandateStatusActive
+	if !CanCancel(m) || CanReinstate(m) {
+		t.Fatal("Expected active mandate to be cancellable only")
+	}
+}
+
+func TestCheckSubscriptionPause(t *testing.T) {
+	s := &gocardless.Subscription{Id: "SB123", Status: gocardless.SubscriptionStatusActive}
+	if !CanPause(s) || CanResume(s) {
+		t.Fatal("Expected open-ended subscription to be pausable")
+	}
+
+	s.EndDate = gocardless.NewDate(2030, 1, 1)
+	err := Check(Pause, s)
+	expected := "cannot pause subscription SB123: only subscriptions created with a count or without an end date can be paused"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected %q, got %v", expected, err)
+	}
+
+	s.Count = 12
+	if !CanPause(s) {
+		t.Fatal("Expected subscription with a count to be pausable")
+	}
+	s.Status = gocardless.SubscriptionStatusFinished
+	if CanPause(s) || CanCancel(s) {
+		t.Fatal("Expected finished subscription to allow no actions")
+	}
+}
+
+func TestCheckBillingRequest(t *testing.T) {
+	br := &gocardless.BillingRequest{Status: gocardless.BillingRequestStatusPending}
+	if !CanCancel(br) || CanFulfil(br) {
+		t.Fatal("Expected pending billing request to be cancellable only")
+	}
+	br.Status = gocardless.BillingRequestStatusReadyToFulfil
+	if !CanFulfil(br) {
+		t.Fatal("Expected billing request to be fulfillable")
+	}
+}
+
+func TestTransitionsEndInTerminalStatuses(t *testing.T) {
+	for _, tc := range []struct {
+		m        *Machine
+		terminal func(string) bool
+	}{
+		{Payments, func(s string) bool { return gocardless.PaymentStatus(s).IsTerminal() }},
+		{Mandates, func(s string) bool { return gocardless.MandateStatus(s).IsTerminal() }},
+		{Subscriptions, func(s string) bool { return gocardless.SubscriptionStatus(s).IsTerminal() }},
+		{InstalmentSchedules, func(s string) bool { return gocardless.InstalmentScheduleStatus(s).IsTerminal() }},
+		{Refunds, func(s string) bool { return gocardless.RefundStatus(s).IsTerminal() }},
+		{Payouts, func(s string) bool { return gocardless.PayoutStatus(s).IsTerminal() }},
+		{BillingRequests, func(s string) bool { return gocardless.BillingRequestStatus(s).IsTerminal() }},
+	} {
+		for _, s := range tc.m.Statuses() {
+			if tc.terminal(s) != (len(tc.m.Next(s)) == 0) {
+				t.Fatalf("Unexpected transitions from %s status %s: %v", tc.m.Resource, s, tc.m.Next(s))
+			}
+			for _, next := range tc.m.Next(s) {
+				if !tc.m.Known(next) {
+					t.Fatalf("Unknown %s status %s", tc.m.Resource, next)
+				}
+			}
+		}
+	}
+	if !Payments.CanTransition("failed", "submitted") || Payments.CanTransition("cancelled", "submitted") {
+		t.Fatal("Unexpected payment transitions")
+	}
+}
+
+func TestActionsLeadToTheirStatus(t *testing.T) {
+	targets := map[Action]string{
+		Cancel:    "cancelled",
+		Retry:     "pending_submission",
+		Reinstate: "pending_submission",
+		Pause:     "paused",
+		Resume:    "active",
+		Fulfil:    "fulfilling",
+	}
+	for _, m := range []*Machine{Payments, Mandates, Subscriptions, InstalmentSchedules, Refunds, Payouts, BillingRequests} {
+		for action, target := range targets {
+			for _, status := range m.Allowed(action) {
+				if !m.CanTransition(status, target) {
+					t.Fatalf("Expected %s in status %q to be able to move to %q on %s", m.Resource, status, target, action)
+				}
+			}
+		}
+	}
+}
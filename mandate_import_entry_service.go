@@ -24,17 +24,20 @@ type MandateImportEntryServiceImpl struct {
 }
 
 type MandateImportEntryLinks struct {
-	Customer            string `url:"customer,omitempty" json:"customer,omitempty"`
-	CustomerBankAccount string `url:"customer_bank_account,omitempty" json:"customer_bank_account,omitempty"`
-	Mandate             string `url:"mandate,omitempty" json:"mandate,omitempty"`
-	MandateImport       string `url:"mandate_import,omitempty" json:"mandate_import,omitempty"`
+	Customer            string                     `url:"customer,omitempty" json:"customer,omitempty"`
+	CustomerBankAccount string                     `url:"customer_bank_account,omitempty" json:"customer_bank_account,omitempty"`
+	Mandate             string                     `url:"mandate,omitempty" json:"mandate,omitempty"`
+	MandateImport       string                     `url:"mandate_import,omitempty" json:"mandate_import,omitempty"`
+	Extra               map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 // MandateImportEntry model
 type MandateImportEntry struct {
-	CreatedAt        string                   `url:"created_at,omitempty" json:"created_at,omitempty"`
-	Links            *MandateImportEntryLinks `url:"links,omitempty" json:"links,omitempty"`
-	RecordIdentifier string                   `url:"record_identifier,omitempty" json:"record_identifier,omitempty"`
+	CreatedAt        *Timestamp                 `url:"created_at,omitempty" json:"created_at,omitempty"`
+	Links            *MandateImportEntryLinks   `url:"links,omitempty" json:"links,omitempty"`
+	RecordIdentifier string                     `url:"record_identifier,omitempty" json:"record_identifier,omitempty"`
+	Extra            map[string]json.RawMessage `url:"-" json:"-"`
+	raw              json.RawMessage
 }
 
 type MandateImportEntryService interface {
@@ -98,6 +101,10 @@ type MandateImportEntryCreateParams struct {
 // If you attempt to go over this limit, the API will return a
 // `record_limit_exceeded` error.
 func (s *MandateImportEntryServiceImpl) Create(ctx context.Context, p MandateImportEntryCreateParams, opts ...RequestOption) (*MandateImportEntry, error) {
+	if err := validateParams("mandate_import_entries", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/mandate_import_entries"))
 	if err != nil {
 		return nil, err
@@ -25,10 +25,12 @@ type MandateImportServiceImpl struct {
 
 // MandateImport model
 type MandateImport struct {
-	CreatedAt string `url:"created_at,omitempty" json:"created_at,omitempty"`
-	Id        string `url:"id,omitempty" json:"id,omitempty"`
-	Scheme    string `url:"scheme,omitempty" json:"scheme,omitempty"`
-	Status    string `url:"status,omitempty" json:"status,omitempty"`
+	CreatedAt *Timestamp                 `url:"created_at,omitempty" json:"created_at,omitempty"`
+	Id        string                     `url:"id,omitempty" json:"id,omitempty"`
+	Scheme    string                     `url:"scheme,omitempty" json:"scheme,omitempty"`
+	Status    string                     `url:"status,omitempty" json:"status,omitempty"`
+	Extra     map[string]json.RawMessage `url:"-" json:"-"`
+	raw       json.RawMessage
 }
 
 type MandateImportService interface {
@@ -51,6 +53,10 @@ type MandateImportCreateParams struct {
 // adding entries to an import, you should
 // [submit](#mandate-imports-submit-a-mandate-import) it.
 func (s *MandateImportServiceImpl) Create(ctx context.Context, p MandateImportCreateParams, opts ...RequestOption) (*MandateImport, error) {
+	if err := validateParams("mandate_imports", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/mandate_imports"))
 	if err != nil {
 		return nil, err
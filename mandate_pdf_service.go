@@ -25,8 +25,10 @@ type MandatePdfServiceImpl struct {
 
 // MandatePdf model
 type MandatePdf struct {
-	ExpiresAt string `url:"expires_at,omitempty" json:"expires_at,omitempty"`
-	Url       string `url:"url,omitempty" json:"url,omitempty"`
+	ExpiresAt *Timestamp                 `url:"expires_at,omitempty" json:"expires_at,omitempty"`
+	Url       string                     `url:"url,omitempty" json:"url,omitempty"`
+	Extra     map[string]json.RawMessage `url:"-" json:"-"`
+	raw       json.RawMessage
 }
 
 type MandatePdfService interface {
@@ -59,7 +61,7 @@ type MandatePdfCreateParams struct {
 	PostalCode            string                       `url:"postal_code,omitempty" json:"postal_code,omitempty"`
 	Region                string                       `url:"region,omitempty" json:"region,omitempty"`
 	Scheme                string                       `url:"scheme,omitempty" json:"scheme,omitempty"`
-	SignatureDate         string                       `url:"signature_date,omitempty" json:"signature_date,omitempty"`
+	SignatureDate         *Date                        `url:"signature_date,omitempty" json:"signature_date,omitempty"`
 	SubscriptionAmount    int                          `url:"subscription_amount,omitempty" json:"subscription_amount,omitempty"`
 	SubscriptionFrequency string                       `url:"subscription_frequency,omitempty" json:"subscription_frequency,omitempty"`
 	SwedishIdentityNumber string                       `url:"swedish_identity_number,omitempty" json:"swedish_identity_number,omitempty"`
@@ -110,6 +112,10 @@ type MandatePdfCreateParams struct {
 // (`fr`), German (`de`), Italian (`it`), Portuguese (`pt`), Spanish (`es`),
 // Swedish (`sv`) |
 func (s *MandatePdfServiceImpl) Create(ctx context.Context, p MandatePdfCreateParams, opts ...RequestOption) (*MandatePdf, error) {
+	if err := validateParams("mandate_pdfs", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/mandate_pdfs"))
 	if err != nil {
 		return nil, err
@@ -24,37 +24,42 @@ type MandateServiceImpl struct {
 }
 
 type MandateConsentParametersPeriods struct {
-	MaxAmountPerPeriod   int    `url:"max_amount_per_period,omitempty" json:"max_amount_per_period,omitempty"`
-	MaxPaymentsPerPeriod int    `url:"max_payments_per_period,omitempty" json:"max_payments_per_period,omitempty"`
-	Period               string `url:"period,omitempty" json:"period,omitempty"`
+	MaxAmountPerPeriod   int                        `url:"max_amount_per_period,omitempty" json:"max_amount_per_period,omitempty"`
+	MaxPaymentsPerPeriod int                        `url:"max_payments_per_period,omitempty" json:"max_payments_per_period,omitempty"`
+	Period               string                     `url:"period,omitempty" json:"period,omitempty"`
+	Extra                map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type MandateConsentParameters struct {
-	EndDate             string                            `url:"end_date,omitempty" json:"end_date,omitempty"`
+	EndDate             *Date                             `url:"end_date,omitempty" json:"end_date,omitempty"`
 	MaxAmountPerPayment int                               `url:"max_amount_per_payment,omitempty" json:"max_amount_per_payment,omitempty"`
 	Periods             []MandateConsentParametersPeriods `url:"periods,omitempty" json:"periods,omitempty"`
-	StartDate           string                            `url:"start_date,omitempty" json:"start_date,omitempty"`
+	StartDate           *Date                             `url:"start_date,omitempty" json:"start_date,omitempty"`
+	Extra               map[string]json.RawMessage        `url:"-" json:"-"`
 }
 
 type MandateLinks struct {
-	Creditor            string `url:"creditor,omitempty" json:"creditor,omitempty"`
-	Customer            string `url:"customer,omitempty" json:"customer,omitempty"`
-	CustomerBankAccount string `url:"customer_bank_account,omitempty" json:"customer_bank_account,omitempty"`
-	NewMandate          string `url:"new_mandate,omitempty" json:"new_mandate,omitempty"`
+	Creditor            string                     `url:"creditor,omitempty" json:"creditor,omitempty"`
+	Customer            string                     `url:"customer,omitempty" json:"customer,omitempty"`
+	CustomerBankAccount string                     `url:"customer_bank_account,omitempty" json:"customer_bank_account,omitempty"`
+	NewMandate          string                     `url:"new_mandate,omitempty" json:"new_mandate,omitempty"`
+	Extra               map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 // Mandate model
 type Mandate struct {
-	ConsentParameters       *MandateConsentParameters `url:"consent_parameters,omitempty" json:"consent_parameters,omitempty"`
-	CreatedAt               string                    `url:"created_at,omitempty" json:"created_at,omitempty"`
-	Id                      string                    `url:"id,omitempty" json:"id,omitempty"`
-	Links                   *MandateLinks             `url:"links,omitempty" json:"links,omitempty"`
-	Metadata                map[string]interface{}    `url:"metadata,omitempty" json:"metadata,omitempty"`
-	NextPossibleChargeDate  string                    `url:"next_possible_charge_date,omitempty" json:"next_possible_charge_date,omitempty"`
-	PaymentsRequireApproval bool                      `url:"payments_require_approval,omitempty" json:"payments_require_approval,omitempty"`
-	Reference               string                    `url:"reference,omitempty" json:"reference,omitempty"`
-	Scheme                  string                    `url:"scheme,omitempty" json:"scheme,omitempty"`
-	Status                  string                    `url:"status,omitempty" json:"status,omitempty"`
+	ConsentParameters       *MandateConsentParameters  `url:"consent_parameters,omitempty" json:"consent_parameters,omitempty"`
+	CreatedAt               *Timestamp                 `url:"created_at,omitempty" json:"created_at,omitempty"`
+	Id                      string                     `url:"id,omitempty" json:"id,omitempty"`
+	Links                   *MandateLinks              `url:"links,omitempty" json:"links,omitempty"`
+	Metadata                Metadata                   `url:"metadata,omitempty" json:"metadata,omitempty"`
+	NextPossibleChargeDate  *Date                      `url:"next_possible_charge_date,omitempty" json:"next_possible_charge_date,omitempty"`
+	PaymentsRequireApproval bool                       `url:"payments_require_approval,omitempty" json:"payments_require_approval,omitempty"`
+	Reference               string                     `url:"reference,omitempty" json:"reference,omitempty"`
+	Scheme                  Scheme                     `url:"scheme,omitempty" json:"scheme,omitempty"`
+	Status                  MandateStatus              `url:"status,omitempty" json:"status,omitempty"`
+	Extra                   map[string]json.RawMessage `url:"-" json:"-"`
+	raw                     json.RawMessage
 }
 
 type MandateService interface {
@@ -75,15 +80,19 @@ type MandateCreateParamsLinks struct {
 // MandateCreateParams parameters
 type MandateCreateParams struct {
 	Links          MandateCreateParamsLinks `url:"links,omitempty" json:"links,omitempty"`
-	Metadata       map[string]interface{}   `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata       Metadata                 `url:"metadata,omitempty" json:"metadata,omitempty"`
 	PayerIpAddress string                   `url:"payer_ip_address,omitempty" json:"payer_ip_address,omitempty"`
 	Reference      string                   `url:"reference,omitempty" json:"reference,omitempty"`
-	Scheme         string                   `url:"scheme,omitempty" json:"scheme,omitempty"`
+	Scheme         Scheme                   `url:"scheme,omitempty" json:"scheme,omitempty"`
 }
 
 // Create
 // Creates a new mandate object.
 func (s *MandateServiceImpl) Create(ctx context.Context, p MandateCreateParams, opts ...RequestOption) (*Mandate, error) {
+	if err := validateParams("mandates", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/mandates"))
 	if err != nil {
 		return nil, err
@@ -174,12 +183,7 @@ func (s *MandateServiceImpl) Create(ctx context.Context, p MandateCreateParams,
 	return result.Mandate, nil
 }
 
-type MandateListParamsCreatedAt struct {
-	Gt  string `url:"gt,omitempty" json:"gt,omitempty"`
-	Gte string `url:"gte,omitempty" json:"gte,omitempty"`
-	Lt  string `url:"lt,omitempty" json:"lt,omitempty"`
-	Lte string `url:"lte,omitempty" json:"lte,omitempty"`
-}
+type MandateListParamsCreatedAt = TimestampRange
 
 // MandateListParams parameters
 type MandateListParams struct {
@@ -192,8 +196,8 @@ type MandateListParams struct {
 	Limit               int                         `url:"limit,omitempty" json:"limit,omitempty"`
 	MandateType         string                      `url:"mandate_type,omitempty" json:"mandate_type,omitempty"`
 	Reference           string                      `url:"reference,omitempty" json:"reference,omitempty"`
-	Scheme              []string                    `url:"scheme,omitempty" json:"scheme,omitempty"`
-	Status              []string                    `url:"status,omitempty" json:"status,omitempty"`
+	Scheme              []Scheme                    `url:"scheme,omitempty" json:"scheme,omitempty"`
+	Status              []MandateStatus             `url:"status,omitempty" json:"status,omitempty"`
 }
 
 type MandateListResultMetaCursors struct {
@@ -500,12 +504,16 @@ func (s *MandateServiceImpl) Get(ctx context.Context, identity string, opts ...R
 
 // MandateUpdateParams parameters
 type MandateUpdateParams struct {
-	Metadata map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 // Update
 // Updates a mandate object. This accepts only the metadata parameter.
 func (s *MandateServiceImpl) Update(ctx context.Context, identity string, p MandateUpdateParams, opts ...RequestOption) (*Mandate, error) {
+	if err := validateParams("mandates", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/mandates/%v",
 		identity))
 	if err != nil {
@@ -599,7 +607,7 @@ func (s *MandateServiceImpl) Update(ctx context.Context, identity string, p Mand
 
 // MandateCancelParams parameters
 type MandateCancelParams struct {
-	Metadata map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 // Cancel
@@ -610,6 +618,10 @@ type MandateCancelParams struct {
 // This will fail with a `cancellation_failed` error if the mandate is already
 // cancelled.
 func (s *MandateServiceImpl) Cancel(ctx context.Context, identity string, p MandateCancelParams, opts ...RequestOption) (*Mandate, error) {
+	if err := validateParams("data", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/mandates/%v/actions/cancel",
 		identity))
 	if err != nil {
@@ -703,7 +715,7 @@ func (s *MandateServiceImpl) Cancel(ctx context.Context, identity string, p Mand
 
 // MandateReinstateParams parameters
 type MandateReinstateParams struct {
-	Metadata map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 // Reinstate
@@ -720,6 +732,10 @@ type MandateReinstateParams struct {
 //
 // Mandates can be resubmitted up to 10 times.
 func (s *MandateServiceImpl) Reinstate(ctx context.Context, identity string, p MandateReinstateParams, opts ...RequestOption) (*Mandate, error) {
+	if err := validateParams("data", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/mandates/%v/actions/reinstate",
 		identity))
 	if err != nil {
@@ -0,0 +1,122 @@
+package gocardless
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Limits on the metadata of resources enforced by the API.
+const (
+	MetadataMaxKeys        = 3
+	MetadataMaxKeyLength   = 50
+	MetadataMaxValueLength = 500
+)
+
+// Metadata holds the key-value pairs stored on resources for your own use.
+type Metadata map[string]string
+
+// Validate checks m against the limits of the API. The error returned is
+// an *APIError holding a ValidationError for each violation, as returned
+// by the API.
+func (m Metadata) Validate() error {
+	return newValidationFailedError(m.validate("metadata", "/metadata"))
+}
+
+func (m Metadata) validate(field, pointer string) []ValidationError {
+	var errs []ValidationError
+	if len(m) > MetadataMaxKeys {
+		errs = append(errs, ValidationError{
+			Field:          field,
+			Message:        fmt.Sprintf("must have no more than %d keys", MetadataMaxKeys),
+			RequestPointer: pointer,
+		})
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if utf8.RuneCountInString(k) > MetadataMaxKeyLength {
+			errs = append(errs, ValidationError{
+				Field:          field,
+				Message:        fmt.Sprintf("key %q must be no more than %d characters", k, MetadataMaxKeyLength),
+				RequestPointer: pointer,
+			})
+		}
+		if utf8.RuneCountInString(m[k]) > MetadataMaxValueLength {
+			errs = append(errs, ValidationError{
+				Field:          field,
+				Message:        fmt.Sprintf("value of key %q must be no more than %d characters", k, MetadataMaxValueLength),
+				RequestPointer: pointer + "/" + k,
+			})
+		}
+	}
+	return errs
+}
+
+func newValidationFailedError(errs []ValidationError) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return &APIError{
+		Message: "Validation failed",
+		Type:    "validation_failed",
+		Code:    422,
+		Errors:  errs,
+	}
+}
+
+var (
+	metadataType         = reflect.TypeOf(Metadata{})
+	optionalMetadataType = reflect.TypeOf(OptionalMetadata{})
+)
+
+// validateParams checks the metadata held anywhere in the params p before
+// they are sent under the given envelope, so invalid requests fail without
+// a round trip to the API.
+func validateParams(envelope string, p interface{}) error {
+	return newValidationFailedError(validateValue(reflect.ValueOf(p), "", "/"+envelope))
+}
+
+func validateValue(v reflect.Value, field, pointer string) []ValidationError {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	switch v.Type() {
+	case metadataType:
+		return v.Interface().(Metadata).validate(field, pointer)
+	case optionalMetadataType:
+		m, _ := v.Interface().(OptionalMetadata).Get()
+		return m.validate(field, pointer)
+	}
+
+	var errs []ValidationError
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			sf := v.Type().Field(i)
+			if sf.PkgPath != "" {
+				continue
+			}
+			name := strings.Split(sf.Tag.Get("json"), ",")[0]
+			if name == "" || name == "-" {
+				continue
+			}
+			errs = append(errs, validateValue(v.Field(i), name, pointer+"/"+name)...)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			errs = append(errs, validateValue(v.Index(i), field, fmt.Sprintf("%s/%d", pointer, i))...)
+		}
+	}
+	return errs
+}
@@ -0,0 +1,134 @@
+package gocardless
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMetadataValidate(t *testing.T) {
+	if err := (Metadata{"salesforce_id": "ABCD1234"}).Validate(); err != nil {
+		t.Fatalf("Expected nil, got %v", err)
+	}
+
+	m := Metadata{
+		"a":                     "1",
+		"b":                     strings.Repeat("v", 501),
+		"c":                     "3",
+		strings.Repeat("k", 51): "4",
+	}
+	err := m.Validate()
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %v", err)
+	}
+	if apiErr.Type != "validation_failed" || len(apiErr.Errors) != 3 {
+		t.Fatalf("Unexpected error %v", apiErr)
+	}
+	for _, e := range apiErr.Errors {
+		if e.Field != "metadata" {
+			t.Fatalf("Expected %q, got %q", "metadata", e.Field)
+		}
+	}
+	if apiErr.Errors[1].RequestPointer != "/metadata/b" {
+		t.Fatalf("Expected %q, got %q", "/metadata/b", apiErr.Errors[1].RequestPointer)
+	}
+}
+
+func TestValidateParamsFindsNestedMetadata(t *testing.T) {
+	p := BillingRequestCreateParams{
+		MandateRequest: &BillingRequestCreateParamsMandateRequest{
+			Metadata: Metadata{strings.Repeat("k", 51): "v"},
+		},
+	}
+	err := validateParams("billing_requests", p)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || len(apiErr.Errors) != 1 {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if apiErr.Errors[0].RequestPointer != "/billing_requests/mandate_request/metadata" {
+		t.Fatalf("Unexpected pointer %q", apiErr.Errors[0].RequestPointer)
+	}
+
+	u := PaymentUpdateParams{Metadata: MetadataOf(Metadata{"a": "1", "b": "2", "c": "3", "d": "4"})}
+	if err := validateParams("payments", u); err == nil {
+		t.Fatal("Expected error for optional metadata")
+	}
+}
+
+func TestCreateValidatesMetadataBeforeSending(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	client, err := getClient(t, server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Payments.Create(context.TODO(), PaymentCreateParams{
+		Metadata: Metadata{"a": "1", "b": "2", "c": "3", "d": "4"},
+	})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Errors[0].Field != "metadata" {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if requests != 0 {
+		t.Fatalf("Expected no request, got %d", requests)
+	}
+}
+
+func TestCreateInstalmentScheduleValidatesMetadataBeforeSending(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	client, err := getClient(t, server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	metadata := Metadata{"a": "1", "b": "2", "c": "3", "d": "4"}
+	_, errDates := client.InstalmentSchedules.CreateWithDates(context.TODO(), InstalmentScheduleCreateWithDatesParams{Metadata: metadata})
+	_, errSchedule := client.InstalmentSchedules.CreateWithSchedule(context.TODO(), InstalmentScheduleCreateWithScheduleParams{Metadata: metadata})
+	for _, err := range []error{errDates, errSchedule} {
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.Errors[0].Field != "metadata" {
+			t.Fatalf("Unexpected error %v", err)
+		}
+	}
+	if requests != 0 {
+		t.Fatalf("Expected no request, got %d", requests)
+	}
+}
+
+func TestActionValidatesMetadataBeforeSending(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	client, err := getClient(t, server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Payments.Cancel(context.TODO(), "PM123", PaymentCancelParams{
+		Metadata: MetadataOf(Metadata{strings.Repeat("k", 51): "v"}),
+	})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Errors[0].RequestPointer != "/data/metadata" {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if requests != 0 {
+		t.Fatalf("Expected no request, got %d", requests)
+	}
+}
@@ -0,0 +1,1830 @@
+package gocardless
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Models keep the fields of the API responses they don't know about, e.g.
+// fields added to the API after this version of the library, in their
+// Extra map. Extra fields are encoded back along with the known ones.
+// Resources also keep the JSON they were decoded from, returned by Raw.
+
+// modelFields caches the indexes of the fields of the model types by JSON
+// field name.
+var modelFields sync.Map
+
+func jsonFields(t reflect.Type) map[string]int {
+	if fields, ok := modelFields.Load(t); ok {
+		return fields.(map[string]int)
+	}
+	fields := make(map[string]int)
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			fields[name] = i
+		}
+	}
+	modelFields.Store(t, fields)
+	return fields
+}
+
+// unmarshalModel decodes data into v, a pointer to a model converted to a
+// type without methods, returning the fields v doesn't know about. The
+// object is split into its fields once, and each known field is decoded
+// from its own JSON.
+func unmarshalModel(data []byte, v interface{}) (map[string]json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	m := reflect.ValueOf(v).Elem()
+	known := jsonFields(m.Type())
+	for name, value := range fields {
+		i, ok := known[name]
+		if !ok {
+			continue
+		}
+		if err := json.Unmarshal(value, m.Field(i).Addr().Interface()); err != nil {
+			return nil, err
+		}
+		delete(fields, name)
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return fields, nil
+}
+
+// marshalModel encodes v, a model converted to a type without methods,
+// adding the extra fields it doesn't know about.
+func marshalModel(v interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+
+	known := jsonFields(reflect.TypeOf(v))
+	names := make([]string, 0, len(extra))
+	for name := range extra {
+		if _, ok := known[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.Write(data[:len(data)-1])
+	for _, name := range names {
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(extra[name])
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BankAuthorisationLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BankAuthorisationLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BankAuthorisationLinks) MarshalJSON() ([]byte, error) {
+	type model BankAuthorisationLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BankAuthorisation) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BankAuthorisation
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BankAuthorisation) MarshalJSON() ([]byte, error) {
+	type model BankAuthorisation
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the BankAuthorisation was decoded from.
+func (m BankAuthorisation) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BankDetailsLookup) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BankDetailsLookup
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BankDetailsLookup) MarshalJSON() ([]byte, error) {
+	type model BankDetailsLookup
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the BankDetailsLookup was decoded from.
+func (m BankDetailsLookup) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestFlowLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestFlowLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestFlowLinks) MarshalJSON() ([]byte, error) {
+	type model BillingRequestFlowLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestFlowPrefilledBankAccount) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestFlowPrefilledBankAccount
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestFlowPrefilledBankAccount) MarshalJSON() ([]byte, error) {
+	type model BillingRequestFlowPrefilledBankAccount
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestFlowPrefilledCustomer) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestFlowPrefilledCustomer
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestFlowPrefilledCustomer) MarshalJSON() ([]byte, error) {
+	type model BillingRequestFlowPrefilledCustomer
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestFlow) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestFlow
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestFlow) MarshalJSON() ([]byte, error) {
+	type model BillingRequestFlow
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the BillingRequestFlow was decoded from.
+func (m BillingRequestFlow) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestActionsAvailableCurrencies) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestActionsAvailableCurrencies
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestActionsAvailableCurrencies) MarshalJSON() ([]byte, error) {
+	type model BillingRequestActionsAvailableCurrencies
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestActionsBankAuthorisation) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestActionsBankAuthorisation
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestActionsBankAuthorisation) MarshalJSON() ([]byte, error) {
+	type model BillingRequestActionsBankAuthorisation
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestActionsCollectCustomerDetails) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestActionsCollectCustomerDetails
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestActionsCollectCustomerDetails) MarshalJSON() ([]byte, error) {
+	type model BillingRequestActionsCollectCustomerDetails
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestActions) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestActions
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestActions) MarshalJSON() ([]byte, error) {
+	type model BillingRequestActions
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestLinks) MarshalJSON() ([]byte, error) {
+	type model BillingRequestLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestMandateRequestConstraintsPeriodicLimits) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestMandateRequestConstraintsPeriodicLimits
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestMandateRequestConstraintsPeriodicLimits) MarshalJSON() ([]byte, error) {
+	type model BillingRequestMandateRequestConstraintsPeriodicLimits
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestMandateRequestConstraints) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestMandateRequestConstraints
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestMandateRequestConstraints) MarshalJSON() ([]byte, error) {
+	type model BillingRequestMandateRequestConstraints
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestMandateRequestLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestMandateRequestLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestMandateRequestLinks) MarshalJSON() ([]byte, error) {
+	type model BillingRequestMandateRequestLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestMandateRequest) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestMandateRequest
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestMandateRequest) MarshalJSON() ([]byte, error) {
+	type model BillingRequestMandateRequest
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestPaymentRequestLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestPaymentRequestLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestPaymentRequestLinks) MarshalJSON() ([]byte, error) {
+	type model BillingRequestPaymentRequestLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestPaymentRequest) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestPaymentRequest
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestPaymentRequest) MarshalJSON() ([]byte, error) {
+	type model BillingRequestPaymentRequest
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestResourcesCustomer) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestResourcesCustomer
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestResourcesCustomer) MarshalJSON() ([]byte, error) {
+	type model BillingRequestResourcesCustomer
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestResourcesCustomerBankAccountLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestResourcesCustomerBankAccountLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestResourcesCustomerBankAccountLinks) MarshalJSON() ([]byte, error) {
+	type model BillingRequestResourcesCustomerBankAccountLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestResourcesCustomerBankAccount) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestResourcesCustomerBankAccount
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestResourcesCustomerBankAccount) MarshalJSON() ([]byte, error) {
+	type model BillingRequestResourcesCustomerBankAccount
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestResourcesCustomerBillingDetail) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestResourcesCustomerBillingDetail
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestResourcesCustomerBillingDetail) MarshalJSON() ([]byte, error) {
+	type model BillingRequestResourcesCustomerBillingDetail
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestResources) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestResources
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestResources) MarshalJSON() ([]byte, error) {
+	type model BillingRequestResources
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequest) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequest
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequest) MarshalJSON() ([]byte, error) {
+	type model BillingRequest
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the BillingRequest was decoded from.
+func (m BillingRequest) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *BillingRequestTemplate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model BillingRequestTemplate
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m BillingRequestTemplate) MarshalJSON() ([]byte, error) {
+	type model BillingRequestTemplate
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the BillingRequestTemplate was decoded from.
+func (m BillingRequestTemplate) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *Block) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model Block
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m Block) MarshalJSON() ([]byte, error) {
+	type model Block
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the Block was decoded from.
+func (m Block) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *CreditorBankAccountLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model CreditorBankAccountLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m CreditorBankAccountLinks) MarshalJSON() ([]byte, error) {
+	type model CreditorBankAccountLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *CreditorBankAccount) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model CreditorBankAccount
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m CreditorBankAccount) MarshalJSON() ([]byte, error) {
+	type model CreditorBankAccount
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the CreditorBankAccount was decoded from.
+func (m CreditorBankAccount) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *CreditorLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model CreditorLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m CreditorLinks) MarshalJSON() ([]byte, error) {
+	type model CreditorLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *CreditorSchemeIdentifiers) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model CreditorSchemeIdentifiers
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m CreditorSchemeIdentifiers) MarshalJSON() ([]byte, error) {
+	type model CreditorSchemeIdentifiers
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *Creditor) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model Creditor
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m Creditor) MarshalJSON() ([]byte, error) {
+	type model Creditor
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the Creditor was decoded from.
+func (m Creditor) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *CurrencyExchangeRate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model CurrencyExchangeRate
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m CurrencyExchangeRate) MarshalJSON() ([]byte, error) {
+	type model CurrencyExchangeRate
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the CurrencyExchangeRate was decoded from.
+func (m CurrencyExchangeRate) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *CustomerBankAccountLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model CustomerBankAccountLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m CustomerBankAccountLinks) MarshalJSON() ([]byte, error) {
+	type model CustomerBankAccountLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *CustomerBankAccount) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model CustomerBankAccount
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m CustomerBankAccount) MarshalJSON() ([]byte, error) {
+	type model CustomerBankAccount
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the CustomerBankAccount was decoded from.
+func (m CustomerBankAccount) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *CustomerNotificationLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model CustomerNotificationLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m CustomerNotificationLinks) MarshalJSON() ([]byte, error) {
+	type model CustomerNotificationLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *CustomerNotification) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model CustomerNotification
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m CustomerNotification) MarshalJSON() ([]byte, error) {
+	type model CustomerNotification
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the CustomerNotification was decoded from.
+func (m CustomerNotification) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *Customer) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model Customer
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m Customer) MarshalJSON() ([]byte, error) {
+	type model Customer
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the Customer was decoded from.
+func (m Customer) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *EventCustomerNotifications) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model EventCustomerNotifications
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m EventCustomerNotifications) MarshalJSON() ([]byte, error) {
+	type model EventCustomerNotifications
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *EventDetails) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model EventDetails
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m EventDetails) MarshalJSON() ([]byte, error) {
+	type model EventDetails
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *EventLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model EventLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m EventLinks) MarshalJSON() ([]byte, error) {
+	type model EventLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *Event) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model Event
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m Event) MarshalJSON() ([]byte, error) {
+	type model Event
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the Event was decoded from.
+func (m Event) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *InstalmentScheduleLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model InstalmentScheduleLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m InstalmentScheduleLinks) MarshalJSON() ([]byte, error) {
+	type model InstalmentScheduleLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *InstalmentSchedule) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model InstalmentSchedule
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m InstalmentSchedule) MarshalJSON() ([]byte, error) {
+	type model InstalmentSchedule
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the InstalmentSchedule was decoded from.
+func (m InstalmentSchedule) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *Institution) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model Institution
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m Institution) MarshalJSON() ([]byte, error) {
+	type model Institution
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the Institution was decoded from.
+func (m Institution) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *MandateImportEntryLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model MandateImportEntryLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m MandateImportEntryLinks) MarshalJSON() ([]byte, error) {
+	type model MandateImportEntryLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *MandateImportEntry) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model MandateImportEntry
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m MandateImportEntry) MarshalJSON() ([]byte, error) {
+	type model MandateImportEntry
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the MandateImportEntry was decoded from.
+func (m MandateImportEntry) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *MandateImport) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model MandateImport
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m MandateImport) MarshalJSON() ([]byte, error) {
+	type model MandateImport
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the MandateImport was decoded from.
+func (m MandateImport) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *MandatePdf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model MandatePdf
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m MandatePdf) MarshalJSON() ([]byte, error) {
+	type model MandatePdf
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the MandatePdf was decoded from.
+func (m MandatePdf) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *MandateConsentParametersPeriods) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model MandateConsentParametersPeriods
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m MandateConsentParametersPeriods) MarshalJSON() ([]byte, error) {
+	type model MandateConsentParametersPeriods
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *MandateConsentParameters) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model MandateConsentParameters
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m MandateConsentParameters) MarshalJSON() ([]byte, error) {
+	type model MandateConsentParameters
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *MandateLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model MandateLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m MandateLinks) MarshalJSON() ([]byte, error) {
+	type model MandateLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *Mandate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model Mandate
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m Mandate) MarshalJSON() ([]byte, error) {
+	type model Mandate
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the Mandate was decoded from.
+func (m Mandate) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *PayerAuthorisationBankAccount) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model PayerAuthorisationBankAccount
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m PayerAuthorisationBankAccount) MarshalJSON() ([]byte, error) {
+	type model PayerAuthorisationBankAccount
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *PayerAuthorisationCustomer) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model PayerAuthorisationCustomer
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m PayerAuthorisationCustomer) MarshalJSON() ([]byte, error) {
+	type model PayerAuthorisationCustomer
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *PayerAuthorisationIncompleteFields) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model PayerAuthorisationIncompleteFields
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m PayerAuthorisationIncompleteFields) MarshalJSON() ([]byte, error) {
+	type model PayerAuthorisationIncompleteFields
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *PayerAuthorisationLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model PayerAuthorisationLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m PayerAuthorisationLinks) MarshalJSON() ([]byte, error) {
+	type model PayerAuthorisationLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *PayerAuthorisationMandate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model PayerAuthorisationMandate
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m PayerAuthorisationMandate) MarshalJSON() ([]byte, error) {
+	type model PayerAuthorisationMandate
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *PayerAuthorisation) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model PayerAuthorisation
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m PayerAuthorisation) MarshalJSON() ([]byte, error) {
+	type model PayerAuthorisation
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the PayerAuthorisation was decoded from.
+func (m PayerAuthorisation) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *PaymentFx) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model PaymentFx
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m PaymentFx) MarshalJSON() ([]byte, error) {
+	type model PaymentFx
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *PaymentLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model PaymentLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m PaymentLinks) MarshalJSON() ([]byte, error) {
+	type model PaymentLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *Payment) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model Payment
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m Payment) MarshalJSON() ([]byte, error) {
+	type model Payment
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the Payment was decoded from.
+func (m Payment) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *PayoutItemLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model PayoutItemLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m PayoutItemLinks) MarshalJSON() ([]byte, error) {
+	type model PayoutItemLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *PayoutItemTaxes) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model PayoutItemTaxes
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m PayoutItemTaxes) MarshalJSON() ([]byte, error) {
+	type model PayoutItemTaxes
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *PayoutItem) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model PayoutItem
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m PayoutItem) MarshalJSON() ([]byte, error) {
+	type model PayoutItem
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the PayoutItem was decoded from.
+func (m PayoutItem) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *PayoutFx) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model PayoutFx
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m PayoutFx) MarshalJSON() ([]byte, error) {
+	type model PayoutFx
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *PayoutLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model PayoutLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m PayoutLinks) MarshalJSON() ([]byte, error) {
+	type model PayoutLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *Payout) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model Payout
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m Payout) MarshalJSON() ([]byte, error) {
+	type model Payout
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the Payout was decoded from.
+func (m Payout) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *RedirectFlowLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model RedirectFlowLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m RedirectFlowLinks) MarshalJSON() ([]byte, error) {
+	type model RedirectFlowLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *RedirectFlow) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model RedirectFlow
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m RedirectFlow) MarshalJSON() ([]byte, error) {
+	type model RedirectFlow
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the RedirectFlow was decoded from.
+func (m RedirectFlow) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *RefundFx) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model RefundFx
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m RefundFx) MarshalJSON() ([]byte, error) {
+	type model RefundFx
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *RefundLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model RefundLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m RefundLinks) MarshalJSON() ([]byte, error) {
+	type model RefundLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *Refund) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model Refund
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m Refund) MarshalJSON() ([]byte, error) {
+	type model Refund
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the Refund was decoded from.
+func (m Refund) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *ScenarioSimulator) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model ScenarioSimulator
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m ScenarioSimulator) MarshalJSON() ([]byte, error) {
+	type model ScenarioSimulator
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the ScenarioSimulator was decoded from.
+func (m ScenarioSimulator) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *SubscriptionLinks) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model SubscriptionLinks
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m SubscriptionLinks) MarshalJSON() ([]byte, error) {
+	type model SubscriptionLinks
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *SubscriptionUpcomingPayments) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model SubscriptionUpcomingPayments
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m SubscriptionUpcomingPayments) MarshalJSON() ([]byte, error) {
+	type model SubscriptionUpcomingPayments
+	return marshalModel(model(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *Subscription) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model Subscription
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m Subscription) MarshalJSON() ([]byte, error) {
+	type model Subscription
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the Subscription was decoded from.
+func (m Subscription) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *TaxRate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model TaxRate
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m TaxRate) MarshalJSON() ([]byte, error) {
+	type model TaxRate
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the TaxRate was decoded from.
+func (m TaxRate) Raw() json.RawMessage {
+	return m.raw
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping unknown fields in Extra.
+func (m *Webhook) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	type model Webhook
+	extra, err := unmarshalModel(data, (*model)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	m.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the fields in Extra too.
+func (m Webhook) MarshalJSON() ([]byte, error) {
+	type model Webhook
+	return marshalModel(model(m), m.Extra)
+}
+
+// Raw returns the JSON the Webhook was decoded from.
+func (m Webhook) Raw() json.RawMessage {
+	return m.raw
+}
@@ -0,0 +1,110 @@
+package gocardless
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestModelsKeepUnknownFields(t *testing.T) {
+	data := `{"id":"EV123","action":"created","links":{"payment":"PM123","new_link":"NL123"},"details":{"cause":"payment_created","new_detail":{"nested":[1,2]}},"new_field":true}`
+
+	var e Event
+	if err := json.Unmarshal([]byte(data), &e); err != nil {
+		t.Fatal(err)
+	}
+	if string(e.Raw()) != data {
+		t.Fatalf("Expected %s, got %s", data, e.Raw())
+	}
+	if string(e.Extra["new_field"]) != "true" || len(e.Extra) != 1 {
+		t.Fatalf("Unexpected extra fields %v", e.Extra)
+	}
+	if string(e.Links.Extra["new_link"]) != `"NL123"` {
+		t.Fatalf("Unexpected extra link fields %v", e.Links.Extra)
+	}
+	if string(e.Details.Extra["new_detail"]) != `{"nested":[1,2]}` {
+		t.Fatalf("Unexpected extra detail fields %v", e.Details.Extra)
+	}
+
+	out, err := json.Marshal(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected, got map[string]interface{}
+	json.Unmarshal([]byte(data), &expected)
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatal(err)
+	}
+	expectedJSON, _ := json.Marshal(expected)
+	gotJSON, _ := json.Marshal(got)
+	if string(gotJSON) != string(expectedJSON) {
+		t.Fatalf("Expected %s, got %s", expectedJSON, gotJSON)
+	}
+}
+
+func TestModelsWithoutUnknownFields(t *testing.T) {
+	var p Payment
+	if err := json.Unmarshal([]byte(`{"id":"PM123","amount":1000}`), &p); err != nil {
+		t.Fatal(err)
+	}
+	if p.Extra != nil {
+		t.Fatalf("Expected no extra fields, got %v", p.Extra)
+	}
+	out, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != `{"amount":1000,"id":"PM123"}` {
+		t.Fatalf("Unexpected %s", out)
+	}
+
+	// Extra fields can't override known fields.
+	p.Extra = map[string]json.RawMessage{"id": json.RawMessage(`"PM456"`), "other": json.RawMessage(`1`)}
+	out, err = json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != `{"amount":1000,"id":"PM123","other":1}` {
+		t.Fatalf("Unexpected %s", out)
+	}
+}
+
+func TestModelsReportInvalidFields(t *testing.T) {
+	var p Payment
+	if err := json.Unmarshal([]byte(`{"id":"PM123","amount":"10.00"}`), &p); err == nil {
+		t.Fatal("Expected error for invalid amount")
+	}
+	if err := json.Unmarshal([]byte(`[]`), &p); err == nil {
+		t.Fatal("Expected error for array")
+	}
+}
+
+func TestEnrichedEventRoundTrip(t *testing.T) {
+	var event Event
+	eventJSON := `{"id":"EV123","action":"confirmed","new_field":true}`
+	if err := json.Unmarshal([]byte(eventJSON), &event); err != nil {
+		t.Fatal(err)
+	}
+	e := EnrichedEvent{Event: event, Payment: &Payment{Id: "PM123", Amount: 1000}}
+	out, err := json.Marshal(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"event":{"action":"confirmed","id":"EV123","new_field":true},"payment":{"amount":1000,"id":"PM123"}}`
+	if string(out) != expected {
+		t.Fatalf("Expected %s, got %s", expected, out)
+	}
+
+	var got EnrichedEvent
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Id != "EV123" || got.Payment == nil || got.Payment.Id != "PM123" || got.Payment.Amount != 1000 {
+		t.Fatalf("Unexpected %+v", got)
+	}
+	if len(got.Extra) != 1 || string(got.Extra["new_field"]) != "true" {
+		t.Fatalf("Unexpected extra fields %v", got.Extra)
+	}
+	if raw := `{"action":"confirmed","id":"EV123","new_field":true}`; string(got.Raw()) != raw {
+		t.Fatalf("Expected %s, got %s", raw, got.Raw())
+	}
+}
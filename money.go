@@ -0,0 +1,323 @@
+package gocardless
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Currency is an ISO 4217 currency code.
+type Currency string
+
+// Currencies supported by GoCardless.
+const (
+	CurrencyAUD Currency = "AUD"
+	CurrencyCAD Currency = "CAD"
+	CurrencyDKK Currency = "DKK"
+	CurrencyEUR Currency = "EUR"
+	CurrencyGBP Currency = "GBP"
+	CurrencyNZD Currency = "NZD"
+	CurrencySEK Currency = "SEK"
+	CurrencyUSD Currency = "USD"
+)
+
+// minorUnits holds the number of decimal places of the minor unit of each
+// supported currency, as defined by ISO 4217.
+var minorUnits = map[Currency]int{
+	CurrencyAUD: 2,
+	CurrencyCAD: 2,
+	CurrencyDKK: 2,
+	CurrencyEUR: 2,
+	CurrencyGBP: 2,
+	CurrencyNZD: 2,
+	CurrencySEK: 2,
+	CurrencyUSD: 2,
+}
+
+// IsValid reports whether c is a currency supported by GoCardless.
+func (c Currency) IsValid() bool {
+	_, ok := minorUnits[c]
+	return ok
+}
+
+// MinorUnits returns the number of decimal places of the minor unit of c,
+// e.g. 2 for GBP amounts given in pence.
+func (c Currency) MinorUnits() (int, error) {
+	n, ok := minorUnits[c]
+	if !ok {
+		return 0, fmt.Errorf("unsupported currency %q", string(c))
+	}
+	return n, nil
+}
+
+var (
+	// ErrCurrencyMismatch is returned when combining amounts in different
+	// currencies.
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+	// ErrMoneyOverflow is returned when the result of an operation on
+	// amounts doesn't fit in an int64.
+	ErrMoneyOverflow = errors.New("money amount overflow")
+)
+
+// Money is an amount in the minor unit of its currency, as used by the API,
+// e.g. an Amount of 1000 in GBP is £10.00.
+type Money struct {
+	Amount   int64    `json:"amount"`
+	Currency Currency `json:"currency"`
+}
+
+// NewMoney returns the Money for amount, given in the minor unit of
+// currency.
+func NewMoney(amount int64, currency Currency) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// ParseMoney parses an amount given in the major unit of currency, such as
+// "10.5" or "-0.01". Only '.' is accepted as decimal separator, without digit
+// grouping, and the amount must not be more precise than the minor unit.
+func ParseMoney(amount string, currency Currency) (Money, error) {
+	units, err := currency.MinorUnits()
+	if err != nil {
+		return Money{}, err
+	}
+
+	s := amount
+	neg := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	whole, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, frac = s[:i], s[i+1:]
+	}
+	if whole == "" || !isDigits(whole) || !isDigits(frac) || (strings.Contains(s, ".") && frac == "") {
+		return Money{}, fmt.Errorf("invalid amount %q", amount)
+	}
+	if len(frac) > units {
+		if strings.Trim(frac[units:], "0") != "" {
+			return Money{}, fmt.Errorf("amount %q is more precise than %s allows", amount, string(currency))
+		}
+		frac = frac[:units]
+	}
+	frac += strings.Repeat("0", units-len(frac))
+
+	v, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil {
+		return Money{}, ErrMoneyOverflow
+	}
+	if neg {
+		v = -v
+	}
+	return Money{Amount: v, Currency: currency}, nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Major returns the amount in the major unit of its currency, e.g. "-10.05".
+// The currency must be supported.
+func (m Money) Major() string {
+	units, err := m.Currency.MinorUnits()
+	if err != nil {
+		return strconv.FormatInt(m.Amount, 10)
+	}
+
+	sign := ""
+	if m.Amount < 0 {
+		sign = "-"
+	}
+	// Converting through uint64 handles math.MinInt64.
+	abs := uint64(m.Amount)
+	if m.Amount < 0 {
+		abs = -abs
+	}
+	digits := strconv.FormatUint(abs, 10)
+	if units == 0 {
+		return sign + digits
+	}
+	if len(digits) <= units {
+		digits = strings.Repeat("0", units-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-units] + "." + digits[len(digits)-units:]
+}
+
+// String returns the amount in its major unit followed by the currency,
+// e.g. "10.05 GBP".
+func (m Money) String() string {
+	return m.Major() + " " + string(m.Currency)
+}
+
+// IsZero reports whether the amount is zero.
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// IsNegative reports whether the amount is below zero.
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+// Add returns m + o, failing if they are in different currencies.
+func (m Money) Add(o Money) (Money, error) {
+	if m.Currency != o.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	sum := m.Amount + o.Amount
+	if (o.Amount > 0 && sum < m.Amount) || (o.Amount < 0 && sum > m.Amount) {
+		return Money{}, ErrMoneyOverflow
+	}
+	return Money{Amount: sum, Currency: m.Currency}, nil
+}
+
+// Sub returns m - o, failing if they are in different currencies.
+func (m Money) Sub(o Money) (Money, error) {
+	if o.Amount == math.MinInt64 {
+		return Money{}, ErrMoneyOverflow
+	}
+	return m.Add(o.Neg())
+}
+
+// Neg returns -m.
+func (m Money) Neg() Money {
+	return Money{Amount: -m.Amount, Currency: m.Currency}
+}
+
+// Mul returns m multiplied by n.
+func (m Money) Mul(n int64) (Money, error) {
+	if m.Amount == 0 || n == 0 {
+		return Money{Currency: m.Currency}, nil
+	}
+	p := m.Amount * n
+	if p/n != m.Amount || (m.Amount == -1 && n == math.MinInt64) || (n == -1 && m.Amount == math.MinInt64) {
+		return Money{}, ErrMoneyOverflow
+	}
+	return Money{Amount: p, Currency: m.Currency}, nil
+}
+
+// Cmp compares m and o, returning -1, 0 or +1. It fails if they are in
+// different currencies.
+func (m Money) Cmp(o Money) (int, error) {
+	if m.Currency != o.Currency {
+		return 0, ErrCurrencyMismatch
+	}
+	switch {
+	case m.Amount < o.Amount:
+		return -1, nil
+	case m.Amount > o.Amount:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// SumMoney adds up amounts, failing if they aren't all in the same currency.
+// The sum of no amounts is the zero Money.
+func SumMoney(amounts ...Money) (Money, error) {
+	if len(amounts) == 0 {
+		return Money{}, nil
+	}
+	sum := Money{Currency: amounts[0].Currency}
+	for _, m := range amounts {
+		var err error
+		if sum, err = sum.Add(m); err != nil {
+			return Money{}, err
+		}
+	}
+	return sum, nil
+}
+
+// AmountMoney returns the amount of the payment.
+func (p Payment) AmountMoney() Money {
+	return NewMoney(int64(p.Amount), Currency(p.Currency))
+}
+
+// AmountRefundedMoney returns the amount refunded from the payment.
+func (p Payment) AmountRefundedMoney() Money {
+	return NewMoney(int64(p.AmountRefunded), Currency(p.Currency))
+}
+
+// FxAmountMoney returns the amount after currency exchange.
+func (fx PaymentFx) FxAmountMoney() Money {
+	return NewMoney(int64(fx.FxAmount), Currency(fx.FxCurrency))
+}
+
+// AmountMoney returns the amount of the refund.
+func (r Refund) AmountMoney() Money {
+	return NewMoney(int64(r.Amount), Currency(r.Currency))
+}
+
+// FxAmountMoney returns the amount after currency exchange.
+func (fx RefundFx) FxAmountMoney() Money {
+	return NewMoney(int64(fx.FxAmount), Currency(fx.FxCurrency))
+}
+
+// AmountMoney returns the amount of each payment of the subscription.
+func (s Subscription) AmountMoney() Money {
+	return NewMoney(int64(s.Amount), Currency(s.Currency))
+}
+
+// AppFeeMoney returns the app fee taken from each payment.
+func (s Subscription) AppFeeMoney() Money {
+	return NewMoney(int64(s.AppFee), Currency(s.Currency))
+}
+
+// AmountMoney returns the amount of the payout.
+func (p Payout) AmountMoney() Money {
+	return NewMoney(int64(p.Amount), Currency(p.Currency))
+}
+
+// DeductedFeesMoney returns the fees deducted from the payout.
+func (p Payout) DeductedFeesMoney() Money {
+	return NewMoney(int64(p.DeductedFees), Currency(p.Currency))
+}
+
+// FxAmountMoney returns the amount after currency exchange.
+func (fx PayoutFx) FxAmountMoney() Money {
+	return NewMoney(int64(fx.FxAmount), Currency(fx.FxCurrency))
+}
+
+// AmountMoney returns the amount of the item. Payout items are listed in
+// the currency of their payout, which must be given.
+func (i PayoutItem) AmountMoney(currency Currency) (Money, error) {
+	return ParseMoney(i.Amount, currency)
+}
+
+// AmountMoney returns the amount of the tax.
+func (t PayoutItemTaxes) AmountMoney() (Money, error) {
+	return ParseMoney(t.Amount, Currency(t.Currency))
+}
+
+// DestinationAmountMoney returns the amount of the tax in the currency of
+// the payout.
+func (t PayoutItemTaxes) DestinationAmountMoney() (Money, error) {
+	return ParseMoney(t.DestinationAmount, Currency(t.DestinationCurrency))
+}
+
+// TotalAmountMoney returns the total amount of the instalment schedule.
+func (s InstalmentSchedule) TotalAmountMoney() Money {
+	return NewMoney(int64(s.TotalAmount), Currency(s.Currency))
+}
+
+// AmountMoney returns the amount of the requested payment.
+func (r BillingRequestPaymentRequest) AmountMoney() Money {
+	return NewMoney(int64(r.Amount), Currency(r.Currency))
+}
+
+// AppFeeMoney returns the app fee taken from the requested payment.
+func (r BillingRequestPaymentRequest) AppFeeMoney() Money {
+	return NewMoney(int64(r.AppFee), Currency(r.Currency))
+}
+
+// PaymentRequestAmountMoney returns the amount of the payments requested
+// with the template.
+func (t BillingRequestTemplate) PaymentRequestAmountMoney() Money {
+	return NewMoney(int64(t.PaymentRequestAmount), Currency(t.PaymentRequestCurrency))
+}
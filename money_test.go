@@ -0,0 +1,116 @@
+package gocardless
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	for s, expected := range map[string]int64{
+		"45.0":   4500,
+		"0.01":   1,
+		"-10.5":  -1050,
+		"+3":     300,
+		"1.230":  123,
+		"100000": 10000000,
+	} {
+		m, err := ParseMoney(s, CurrencyGBP)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.Amount != expected || m.Currency != CurrencyGBP {
+			t.Fatalf("Expected %d GBP for %q, got %v", expected, s, m)
+		}
+	}
+
+	for _, s := range []string{"", "-", "1.", ".5", "1,000.00", "1.001", "1e3", "12.3.4"} {
+		if _, err := ParseMoney(s, CurrencyEUR); err == nil {
+			t.Fatalf("Expected error parsing %q", s)
+		}
+	}
+	if _, err := ParseMoney("1.00", "XYZ"); err == nil {
+		t.Fatal("Expected error for unsupported currency")
+	}
+}
+
+func TestMoneyFormat(t *testing.T) {
+	for expected, m := range map[string]Money{
+		"10.05 GBP": NewMoney(1005, CurrencyGBP),
+		"0.05 SEK":  NewMoney(5, CurrencySEK),
+		"-0.50 EUR": NewMoney(-50, CurrencyEUR),
+		"0.00 USD":  NewMoney(0, CurrencyUSD),
+	} {
+		if m.String() != expected {
+			t.Fatalf("Expected %q, got %q", expected, m.String())
+		}
+		parsed, err := ParseMoney(m.Major(), m.Currency)
+		if err != nil || parsed != m {
+			t.Fatalf("Expected %v, got %v (%v)", m, parsed, err)
+		}
+	}
+	if s := NewMoney(math.MinInt64, CurrencyGBP).Major(); s != "-92233720368547758.08" {
+		t.Fatalf("Unexpected %q", s)
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	a, b := NewMoney(1000, CurrencyGBP), NewMoney(250, CurrencyGBP)
+
+	sum, err := a.Add(b)
+	if err != nil || sum != NewMoney(1250, CurrencyGBP) {
+		t.Fatalf("Unexpected sum %v (%v)", sum, err)
+	}
+	diff, err := b.Sub(a)
+	if err != nil || diff != NewMoney(-750, CurrencyGBP) {
+		t.Fatalf("Unexpected difference %v (%v)", diff, err)
+	}
+	if c, _ := a.Cmp(b); c != 1 {
+		t.Fatalf("Expected 1, got %d", c)
+	}
+	total, err := SumMoney(a, b, b)
+	if err != nil || total != NewMoney(1500, CurrencyGBP) {
+		t.Fatalf("Unexpected total %v (%v)", total, err)
+	}
+
+	eur := NewMoney(1000, CurrencyEUR)
+	if _, err := a.Add(eur); err != ErrCurrencyMismatch {
+		t.Fatalf("Expected %v, got %v", ErrCurrencyMismatch, err)
+	}
+	if _, err := a.Cmp(eur); err != ErrCurrencyMismatch {
+		t.Fatalf("Expected %v, got %v", ErrCurrencyMismatch, err)
+	}
+	if _, err := SumMoney(a, eur); err != ErrCurrencyMismatch {
+		t.Fatalf("Expected %v, got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := NewMoney(math.MaxInt64, CurrencyGBP).Add(NewMoney(1, CurrencyGBP)); err != ErrMoneyOverflow {
+		t.Fatalf("Expected %v, got %v", ErrMoneyOverflow, err)
+	}
+	if _, err := NewMoney(math.MaxInt64/2+1, CurrencyGBP).Mul(2); err != ErrMoneyOverflow {
+		t.Fatalf("Expected %v, got %v", ErrMoneyOverflow, err)
+	}
+	if m, err := b.Mul(-3); err != nil || m != NewMoney(-750, CurrencyGBP) {
+		t.Fatalf("Unexpected product %v (%v)", m, err)
+	}
+}
+
+func TestModelMoneyAccessors(t *testing.T) {
+	var payout Payout
+	if err := json.Unmarshal([]byte(`{"amount":1000,"deducted_fees":20,"currency":"GBP"}`), &payout); err != nil {
+		t.Fatal(err)
+	}
+	if payout.AmountMoney() != NewMoney(1000, CurrencyGBP) || payout.DeductedFeesMoney() != NewMoney(20, CurrencyGBP) {
+		t.Fatalf("Unexpected amounts %v, %v", payout.AmountMoney(), payout.DeductedFeesMoney())
+	}
+
+	item := PayoutItem{Amount: "45.0", Taxes: []PayoutItemTaxes{{Amount: "1.1", Currency: "EUR"}}}
+	m, err := item.AmountMoney(Currency(payout.Currency))
+	if err != nil || m != NewMoney(4500, CurrencyGBP) {
+		t.Fatalf("Unexpected item amount %v (%v)", m, err)
+	}
+	tax, err := item.Taxes[0].AmountMoney()
+	if err != nil || tax != NewMoney(110, CurrencyEUR) {
+		t.Fatalf("Unexpected tax amount %v (%v)", tax, err)
+	}
+}
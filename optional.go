@@ -0,0 +1,242 @@
+package gocardless
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// The optional types are used by update and action params for the fields
+// which can be left unchanged, set to a zero value or cleared. A nil field
+// is left out of the request, while a field set with the matching
+// constructor, e.g. NewOptionalBool(false) or NullBool(), is always sent.
+// Null can only be sent in JSON bodies: form encoding has no null, so a null
+// param is encoded as an empty value there.
+
+// OptionalBool is a boolean param which can be set to null.
+type OptionalBool struct {
+	value bool
+	null  bool
+}
+
+// NewOptionalBool returns an OptionalBool set to v.
+func NewOptionalBool(v bool) *OptionalBool {
+	return &OptionalBool{value: v}
+}
+
+// NullBool returns an OptionalBool set to null.
+func NullBool() *OptionalBool {
+	return &OptionalBool{null: true}
+}
+
+// Get returns the value, ok being false if it is null.
+func (o OptionalBool) Get() (v bool, ok bool) {
+	return o.value, !o.null
+}
+
+// IsNull reports whether the param is set to null.
+func (o OptionalBool) IsNull() bool {
+	return o.null
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o OptionalBool) MarshalJSON() ([]byte, error) {
+	if o.null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalBool) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*o = OptionalBool{null: true}
+		return nil
+	}
+	*o = OptionalBool{}
+	return json.Unmarshal(data, &o.value)
+}
+
+// EncodeValues implements query.Encoder. Null is encoded as an empty value.
+func (o OptionalBool) EncodeValues(key string, v *url.Values) error {
+	if o.null {
+		v.Set(key, "")
+		return nil
+	}
+	v.Set(key, strconv.FormatBool(o.value))
+	return nil
+}
+
+// OptionalInt is an integer param which can be set to null.
+type OptionalInt struct {
+	value int
+	null  bool
+}
+
+// NewOptionalInt returns an OptionalInt set to v.
+func NewOptionalInt(v int) *OptionalInt {
+	return &OptionalInt{value: v}
+}
+
+// NullInt returns an OptionalInt set to null.
+func NullInt() *OptionalInt {
+	return &OptionalInt{null: true}
+}
+
+// Get returns the value, ok being false if it is null.
+func (o OptionalInt) Get() (v int, ok bool) {
+	return o.value, !o.null
+}
+
+// IsNull reports whether the param is set to null.
+func (o OptionalInt) IsNull() bool {
+	return o.null
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o OptionalInt) MarshalJSON() ([]byte, error) {
+	if o.null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalInt) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*o = OptionalInt{null: true}
+		return nil
+	}
+	*o = OptionalInt{}
+	return json.Unmarshal(data, &o.value)
+}
+
+// EncodeValues implements query.Encoder. Null is encoded as an empty value.
+func (o OptionalInt) EncodeValues(key string, v *url.Values) error {
+	if o.null {
+		v.Set(key, "")
+		return nil
+	}
+	v.Set(key, strconv.Itoa(o.value))
+	return nil
+}
+
+// OptionalString is a string param which can be set to null.
+type OptionalString struct {
+	value string
+	null  bool
+}
+
+// NewOptionalString returns an OptionalString set to v.
+func NewOptionalString(v string) *OptionalString {
+	return &OptionalString{value: v}
+}
+
+// NullString returns an OptionalString set to null.
+func NullString() *OptionalString {
+	return &OptionalString{null: true}
+}
+
+// Get returns the value, ok being false if it is null.
+func (o OptionalString) Get() (v string, ok bool) {
+	return o.value, !o.null
+}
+
+// IsNull reports whether the param is set to null.
+func (o OptionalString) IsNull() bool {
+	return o.null
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o OptionalString) MarshalJSON() ([]byte, error) {
+	if o.null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalString) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*o = OptionalString{null: true}
+		return nil
+	}
+	*o = OptionalString{}
+	return json.Unmarshal(data, &o.value)
+}
+
+// EncodeValues implements query.Encoder. Null is encoded as an empty value,
+// like the empty string.
+func (o OptionalString) EncodeValues(key string, v *url.Values) error {
+	if o.null {
+		v.Set(key, "")
+		return nil
+	}
+	v.Set(key, o.value)
+	return nil
+}
+
+// OptionalMetadata is a metadata param which can be set to null. Setting it
+// to an empty map clears the metadata.
+type OptionalMetadata struct {
+	value Metadata
+	null  bool
+}
+
+// MetadataOf returns an OptionalMetadata set to m. A nil m is sent as an
+// empty map.
+func MetadataOf(m Metadata) *OptionalMetadata {
+	if m == nil {
+		m = Metadata{}
+	}
+	return &OptionalMetadata{value: m}
+}
+
+// NullMetadata returns an OptionalMetadata set to null.
+func NullMetadata() *OptionalMetadata {
+	return &OptionalMetadata{null: true}
+}
+
+// Get returns the value, ok being false if it is null.
+func (o OptionalMetadata) Get() (v Metadata, ok bool) {
+	return o.value, !o.null
+}
+
+// IsNull reports whether the param is set to null.
+func (o OptionalMetadata) IsNull() bool {
+	return o.null
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o OptionalMetadata) MarshalJSON() ([]byte, error) {
+	if o.null {
+		return []byte("null"), nil
+	}
+	if o.value == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalMetadata) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*o = OptionalMetadata{null: true}
+		return nil
+	}
+	*o = OptionalMetadata{}
+	return json.Unmarshal(data, &o.value)
+}
+
+// EncodeValues implements query.Encoder, encoding each key as key[name].
+// Null and empty metadata are encoded as an empty value.
+func (o OptionalMetadata) EncodeValues(key string, v *url.Values) error {
+	if len(o.value) == 0 {
+		v.Set(key, "")
+		return nil
+	}
+	for k, val := range o.value {
+		v.Set(key+"["+k+"]", val)
+	}
+	return nil
+}
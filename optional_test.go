@@ -0,0 +1,79 @@
+package gocardless
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestOptionalParamsJSON(t *testing.T) {
+	for expected, p := range map[string]interface{}{
+		`{}`:                           PaymentUpdateParams{},
+		`{"retry_if_possible":false}`:  PaymentUpdateParams{RetryIfPossible: NewOptionalBool(false)},
+		`{"metadata":{}}`:              PaymentUpdateParams{Metadata: MetadataOf(nil)},
+		`{"metadata":{"key":"value"}}`: PaymentUpdateParams{Metadata: MetadataOf(Metadata{"key": "value"})},
+		`{"app_fee":0,"name":""}`:      SubscriptionUpdateParams{AppFee: NewOptionalInt(0), Name: NewOptionalString("")},
+		`{"region":null}`:              CustomerUpdateParams{Region: NullString()},
+		`{"pause_cycles":null}`:        SubscriptionPauseParams{PauseCycles: NullInt()},
+	} {
+		data, err := json.Marshal(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != expected {
+			t.Fatalf("Expected %s, got %s", expected, data)
+		}
+	}
+}
+
+func TestOptionalParamsQuery(t *testing.T) {
+	v, err := query.Values(SubscriptionUpdateParams{
+		AppFee:          NewOptionalInt(0),
+		Metadata:        MetadataOf(Metadata{"key": "value"}),
+		RetryIfPossible: NewOptionalBool(false),
+		Name:            NullString(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "app_fee=0&metadata%5Bkey%5D=value&name=&retry_if_possible=false"
+	if v.Encode() != expected {
+		t.Fatalf("Expected %q, got %q", expected, v.Encode())
+	}
+}
+
+func TestOptionalUnmarshal(t *testing.T) {
+	var p SubscriptionUpdateParams
+	if err := json.Unmarshal([]byte(`{"amount":0,"retry_if_possible":true}`), &p); err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := p.Amount.Get(); !ok || v != 0 {
+		t.Fatalf("Expected 0, got %v (%v)", v, ok)
+	}
+	if v, ok := p.RetryIfPossible.Get(); !ok || !v {
+		t.Fatalf("Expected true, got %v (%v)", v, ok)
+	}
+	if p.Name != nil {
+		t.Fatalf("Expected unset name, got %v", p.Name)
+	}
+
+	var b OptionalBool
+	if err := b.UnmarshalJSON([]byte("null")); err != nil || !b.IsNull() {
+		t.Fatalf("Expected null, got %v (%v)", b, err)
+	}
+}
+
+func TestOptionalNullQuery(t *testing.T) {
+	v, err := query.Values(struct {
+		B *OptionalBool   `url:"b,omitempty"`
+		I *OptionalInt    `url:"i,omitempty"`
+		S *OptionalString `url:"s,omitempty"`
+	}{NullBool(), NullInt(), NullString()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "b=&i=&s="; v.Encode() != expected {
+		t.Fatalf("Expected %q, got %q", expected, v.Encode())
+	}
+}
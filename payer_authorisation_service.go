@@ -24,66 +24,73 @@ type PayerAuthorisationServiceImpl struct {
 }
 
 type PayerAuthorisationBankAccount struct {
-	AccountHolderName   string                 `url:"account_holder_name,omitempty" json:"account_holder_name,omitempty"`
-	AccountNumber       string                 `url:"account_number,omitempty" json:"account_number,omitempty"`
-	AccountNumberEnding string                 `url:"account_number_ending,omitempty" json:"account_number_ending,omitempty"`
-	AccountNumberSuffix string                 `url:"account_number_suffix,omitempty" json:"account_number_suffix,omitempty"`
-	AccountType         string                 `url:"account_type,omitempty" json:"account_type,omitempty"`
-	BankCode            string                 `url:"bank_code,omitempty" json:"bank_code,omitempty"`
-	BranchCode          string                 `url:"branch_code,omitempty" json:"branch_code,omitempty"`
-	CountryCode         string                 `url:"country_code,omitempty" json:"country_code,omitempty"`
-	Currency            string                 `url:"currency,omitempty" json:"currency,omitempty"`
-	Iban                string                 `url:"iban,omitempty" json:"iban,omitempty"`
-	Metadata            map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
+	AccountHolderName   string                     `url:"account_holder_name,omitempty" json:"account_holder_name,omitempty"`
+	AccountNumber       string                     `url:"account_number,omitempty" json:"account_number,omitempty"`
+	AccountNumberEnding string                     `url:"account_number_ending,omitempty" json:"account_number_ending,omitempty"`
+	AccountNumberSuffix string                     `url:"account_number_suffix,omitempty" json:"account_number_suffix,omitempty"`
+	AccountType         string                     `url:"account_type,omitempty" json:"account_type,omitempty"`
+	BankCode            string                     `url:"bank_code,omitempty" json:"bank_code,omitempty"`
+	BranchCode          string                     `url:"branch_code,omitempty" json:"branch_code,omitempty"`
+	CountryCode         string                     `url:"country_code,omitempty" json:"country_code,omitempty"`
+	Currency            string                     `url:"currency,omitempty" json:"currency,omitempty"`
+	Iban                string                     `url:"iban,omitempty" json:"iban,omitempty"`
+	Metadata            Metadata                   `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Extra               map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type PayerAuthorisationCustomer struct {
-	AddressLine1          string                 `url:"address_line1,omitempty" json:"address_line1,omitempty"`
-	AddressLine2          string                 `url:"address_line2,omitempty" json:"address_line2,omitempty"`
-	AddressLine3          string                 `url:"address_line3,omitempty" json:"address_line3,omitempty"`
-	City                  string                 `url:"city,omitempty" json:"city,omitempty"`
-	CompanyName           string                 `url:"company_name,omitempty" json:"company_name,omitempty"`
-	CountryCode           string                 `url:"country_code,omitempty" json:"country_code,omitempty"`
-	DanishIdentityNumber  string                 `url:"danish_identity_number,omitempty" json:"danish_identity_number,omitempty"`
-	Email                 string                 `url:"email,omitempty" json:"email,omitempty"`
-	FamilyName            string                 `url:"family_name,omitempty" json:"family_name,omitempty"`
-	GivenName             string                 `url:"given_name,omitempty" json:"given_name,omitempty"`
-	Locale                string                 `url:"locale,omitempty" json:"locale,omitempty"`
-	Metadata              map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	PostalCode            string                 `url:"postal_code,omitempty" json:"postal_code,omitempty"`
-	Region                string                 `url:"region,omitempty" json:"region,omitempty"`
-	SwedishIdentityNumber string                 `url:"swedish_identity_number,omitempty" json:"swedish_identity_number,omitempty"`
+	AddressLine1          string                     `url:"address_line1,omitempty" json:"address_line1,omitempty"`
+	AddressLine2          string                     `url:"address_line2,omitempty" json:"address_line2,omitempty"`
+	AddressLine3          string                     `url:"address_line3,omitempty" json:"address_line3,omitempty"`
+	City                  string                     `url:"city,omitempty" json:"city,omitempty"`
+	CompanyName           string                     `url:"company_name,omitempty" json:"company_name,omitempty"`
+	CountryCode           string                     `url:"country_code,omitempty" json:"country_code,omitempty"`
+	DanishIdentityNumber  string                     `url:"danish_identity_number,omitempty" json:"danish_identity_number,omitempty"`
+	Email                 string                     `url:"email,omitempty" json:"email,omitempty"`
+	FamilyName            string                     `url:"family_name,omitempty" json:"family_name,omitempty"`
+	GivenName             string                     `url:"given_name,omitempty" json:"given_name,omitempty"`
+	Locale                string                     `url:"locale,omitempty" json:"locale,omitempty"`
+	Metadata              Metadata                   `url:"metadata,omitempty" json:"metadata,omitempty"`
+	PostalCode            string                     `url:"postal_code,omitempty" json:"postal_code,omitempty"`
+	Region                string                     `url:"region,omitempty" json:"region,omitempty"`
+	SwedishIdentityNumber string                     `url:"swedish_identity_number,omitempty" json:"swedish_identity_number,omitempty"`
+	Extra                 map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type PayerAuthorisationIncompleteFields struct {
-	Field          string `url:"field,omitempty" json:"field,omitempty"`
-	Message        string `url:"message,omitempty" json:"message,omitempty"`
-	RequestPointer string `url:"request_pointer,omitempty" json:"request_pointer,omitempty"`
+	Field          string                     `url:"field,omitempty" json:"field,omitempty"`
+	Message        string                     `url:"message,omitempty" json:"message,omitempty"`
+	RequestPointer string                     `url:"request_pointer,omitempty" json:"request_pointer,omitempty"`
+	Extra          map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type PayerAuthorisationLinks struct {
-	BankAccount string `url:"bank_account,omitempty" json:"bank_account,omitempty"`
-	Customer    string `url:"customer,omitempty" json:"customer,omitempty"`
-	Mandate     string `url:"mandate,omitempty" json:"mandate,omitempty"`
+	BankAccount string                     `url:"bank_account,omitempty" json:"bank_account,omitempty"`
+	Customer    string                     `url:"customer,omitempty" json:"customer,omitempty"`
+	Mandate     string                     `url:"mandate,omitempty" json:"mandate,omitempty"`
+	Extra       map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type PayerAuthorisationMandate struct {
-	Metadata       map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	PayerIpAddress string                 `url:"payer_ip_address,omitempty" json:"payer_ip_address,omitempty"`
-	Reference      string                 `url:"reference,omitempty" json:"reference,omitempty"`
-	Scheme         string                 `url:"scheme,omitempty" json:"scheme,omitempty"`
+	Metadata       Metadata                   `url:"metadata,omitempty" json:"metadata,omitempty"`
+	PayerIpAddress string                     `url:"payer_ip_address,omitempty" json:"payer_ip_address,omitempty"`
+	Reference      string                     `url:"reference,omitempty" json:"reference,omitempty"`
+	Scheme         string                     `url:"scheme,omitempty" json:"scheme,omitempty"`
+	Extra          map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 // PayerAuthorisation model
 type PayerAuthorisation struct {
 	BankAccount      *PayerAuthorisationBankAccount       `url:"bank_account,omitempty" json:"bank_account,omitempty"`
-	CreatedAt        string                               `url:"created_at,omitempty" json:"created_at,omitempty"`
+	CreatedAt        *Timestamp                           `url:"created_at,omitempty" json:"created_at,omitempty"`
 	Customer         *PayerAuthorisationCustomer          `url:"customer,omitempty" json:"customer,omitempty"`
 	Id               string                               `url:"id,omitempty" json:"id,omitempty"`
 	IncompleteFields []PayerAuthorisationIncompleteFields `url:"incomplete_fields,omitempty" json:"incomplete_fields,omitempty"`
 	Links            *PayerAuthorisationLinks             `url:"links,omitempty" json:"links,omitempty"`
 	Mandate          *PayerAuthorisationMandate           `url:"mandate,omitempty" json:"mandate,omitempty"`
 	Status           string                               `url:"status,omitempty" json:"status,omitempty"`
+	Extra            map[string]json.RawMessage           `url:"-" json:"-"`
+	raw              json.RawMessage
 }
 
 type PayerAuthorisationService interface {
@@ -176,42 +183,42 @@ func (s *PayerAuthorisationServiceImpl) Get(ctx context.Context, identity string
 }
 
 type PayerAuthorisationCreateParamsBankAccount struct {
-	AccountHolderName   string                 `url:"account_holder_name,omitempty" json:"account_holder_name,omitempty"`
-	AccountNumber       string                 `url:"account_number,omitempty" json:"account_number,omitempty"`
-	AccountNumberEnding string                 `url:"account_number_ending,omitempty" json:"account_number_ending,omitempty"`
-	AccountNumberSuffix string                 `url:"account_number_suffix,omitempty" json:"account_number_suffix,omitempty"`
-	AccountType         string                 `url:"account_type,omitempty" json:"account_type,omitempty"`
-	BankCode            string                 `url:"bank_code,omitempty" json:"bank_code,omitempty"`
-	BranchCode          string                 `url:"branch_code,omitempty" json:"branch_code,omitempty"`
-	CountryCode         string                 `url:"country_code,omitempty" json:"country_code,omitempty"`
-	Currency            string                 `url:"currency,omitempty" json:"currency,omitempty"`
-	Iban                string                 `url:"iban,omitempty" json:"iban,omitempty"`
-	Metadata            map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
+	AccountHolderName   string   `url:"account_holder_name,omitempty" json:"account_holder_name,omitempty"`
+	AccountNumber       string   `url:"account_number,omitempty" json:"account_number,omitempty"`
+	AccountNumberEnding string   `url:"account_number_ending,omitempty" json:"account_number_ending,omitempty"`
+	AccountNumberSuffix string   `url:"account_number_suffix,omitempty" json:"account_number_suffix,omitempty"`
+	AccountType         string   `url:"account_type,omitempty" json:"account_type,omitempty"`
+	BankCode            string   `url:"bank_code,omitempty" json:"bank_code,omitempty"`
+	BranchCode          string   `url:"branch_code,omitempty" json:"branch_code,omitempty"`
+	CountryCode         string   `url:"country_code,omitempty" json:"country_code,omitempty"`
+	Currency            string   `url:"currency,omitempty" json:"currency,omitempty"`
+	Iban                string   `url:"iban,omitempty" json:"iban,omitempty"`
+	Metadata            Metadata `url:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 type PayerAuthorisationCreateParamsCustomer struct {
-	AddressLine1          string                 `url:"address_line1,omitempty" json:"address_line1,omitempty"`
-	AddressLine2          string                 `url:"address_line2,omitempty" json:"address_line2,omitempty"`
-	AddressLine3          string                 `url:"address_line3,omitempty" json:"address_line3,omitempty"`
-	City                  string                 `url:"city,omitempty" json:"city,omitempty"`
-	CompanyName           string                 `url:"company_name,omitempty" json:"company_name,omitempty"`
-	CountryCode           string                 `url:"country_code,omitempty" json:"country_code,omitempty"`
-	DanishIdentityNumber  string                 `url:"danish_identity_number,omitempty" json:"danish_identity_number,omitempty"`
-	Email                 string                 `url:"email,omitempty" json:"email,omitempty"`
-	FamilyName            string                 `url:"family_name,omitempty" json:"family_name,omitempty"`
-	GivenName             string                 `url:"given_name,omitempty" json:"given_name,omitempty"`
-	Locale                string                 `url:"locale,omitempty" json:"locale,omitempty"`
-	Metadata              map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	PostalCode            string                 `url:"postal_code,omitempty" json:"postal_code,omitempty"`
-	Region                string                 `url:"region,omitempty" json:"region,omitempty"`
-	SwedishIdentityNumber string                 `url:"swedish_identity_number,omitempty" json:"swedish_identity_number,omitempty"`
+	AddressLine1          string   `url:"address_line1,omitempty" json:"address_line1,omitempty"`
+	AddressLine2          string   `url:"address_line2,omitempty" json:"address_line2,omitempty"`
+	AddressLine3          string   `url:"address_line3,omitempty" json:"address_line3,omitempty"`
+	City                  string   `url:"city,omitempty" json:"city,omitempty"`
+	CompanyName           string   `url:"company_name,omitempty" json:"company_name,omitempty"`
+	CountryCode           string   `url:"country_code,omitempty" json:"country_code,omitempty"`
+	DanishIdentityNumber  string   `url:"danish_identity_number,omitempty" json:"danish_identity_number,omitempty"`
+	Email                 string   `url:"email,omitempty" json:"email,omitempty"`
+	FamilyName            string   `url:"family_name,omitempty" json:"family_name,omitempty"`
+	GivenName             string   `url:"given_name,omitempty" json:"given_name,omitempty"`
+	Locale                string   `url:"locale,omitempty" json:"locale,omitempty"`
+	Metadata              Metadata `url:"metadata,omitempty" json:"metadata,omitempty"`
+	PostalCode            string   `url:"postal_code,omitempty" json:"postal_code,omitempty"`
+	Region                string   `url:"region,omitempty" json:"region,omitempty"`
+	SwedishIdentityNumber string   `url:"swedish_identity_number,omitempty" json:"swedish_identity_number,omitempty"`
 }
 
 type PayerAuthorisationCreateParamsMandate struct {
-	Metadata       map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	PayerIpAddress string                 `url:"payer_ip_address,omitempty" json:"payer_ip_address,omitempty"`
-	Reference      string                 `url:"reference,omitempty" json:"reference,omitempty"`
-	Scheme         string                 `url:"scheme,omitempty" json:"scheme,omitempty"`
+	Metadata       Metadata `url:"metadata,omitempty" json:"metadata,omitempty"`
+	PayerIpAddress string   `url:"payer_ip_address,omitempty" json:"payer_ip_address,omitempty"`
+	Reference      string   `url:"reference,omitempty" json:"reference,omitempty"`
+	Scheme         string   `url:"scheme,omitempty" json:"scheme,omitempty"`
 }
 
 // PayerAuthorisationCreateParams parameters
@@ -229,6 +236,10 @@ type PayerAuthorisationCreateParams struct {
 // servers or the browser while still being able to implement a progressive
 // solution, such as a multi-step form.
 func (s *PayerAuthorisationServiceImpl) Create(ctx context.Context, p PayerAuthorisationCreateParams, opts ...RequestOption) (*PayerAuthorisation, error) {
+	if err := validateParams("payer_authorisations", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/payer_authorisations"))
 	if err != nil {
 		return nil, err
@@ -320,42 +331,42 @@ func (s *PayerAuthorisationServiceImpl) Create(ctx context.Context, p PayerAutho
 }
 
 type PayerAuthorisationUpdateParamsBankAccount struct {
-	AccountHolderName   string                 `url:"account_holder_name,omitempty" json:"account_holder_name,omitempty"`
-	AccountNumber       string                 `url:"account_number,omitempty" json:"account_number,omitempty"`
-	AccountNumberEnding string                 `url:"account_number_ending,omitempty" json:"account_number_ending,omitempty"`
-	AccountNumberSuffix string                 `url:"account_number_suffix,omitempty" json:"account_number_suffix,omitempty"`
-	AccountType         string                 `url:"account_type,omitempty" json:"account_type,omitempty"`
-	BankCode            string                 `url:"bank_code,omitempty" json:"bank_code,omitempty"`
-	BranchCode          string                 `url:"branch_code,omitempty" json:"branch_code,omitempty"`
-	CountryCode         string                 `url:"country_code,omitempty" json:"country_code,omitempty"`
-	Currency            string                 `url:"currency,omitempty" json:"currency,omitempty"`
-	Iban                string                 `url:"iban,omitempty" json:"iban,omitempty"`
-	Metadata            map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
+	AccountHolderName   *OptionalString   `url:"account_holder_name,omitempty" json:"account_holder_name,omitempty"`
+	AccountNumber       *OptionalString   `url:"account_number,omitempty" json:"account_number,omitempty"`
+	AccountNumberEnding *OptionalString   `url:"account_number_ending,omitempty" json:"account_number_ending,omitempty"`
+	AccountNumberSuffix *OptionalString   `url:"account_number_suffix,omitempty" json:"account_number_suffix,omitempty"`
+	AccountType         *OptionalString   `url:"account_type,omitempty" json:"account_type,omitempty"`
+	BankCode            *OptionalString   `url:"bank_code,omitempty" json:"bank_code,omitempty"`
+	BranchCode          *OptionalString   `url:"branch_code,omitempty" json:"branch_code,omitempty"`
+	CountryCode         *OptionalString   `url:"country_code,omitempty" json:"country_code,omitempty"`
+	Currency            *OptionalString   `url:"currency,omitempty" json:"currency,omitempty"`
+	Iban                *OptionalString   `url:"iban,omitempty" json:"iban,omitempty"`
+	Metadata            *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 type PayerAuthorisationUpdateParamsCustomer struct {
-	AddressLine1          string                 `url:"address_line1,omitempty" json:"address_line1,omitempty"`
-	AddressLine2          string                 `url:"address_line2,omitempty" json:"address_line2,omitempty"`
-	AddressLine3          string                 `url:"address_line3,omitempty" json:"address_line3,omitempty"`
-	City                  string                 `url:"city,omitempty" json:"city,omitempty"`
-	CompanyName           string                 `url:"company_name,omitempty" json:"company_name,omitempty"`
-	CountryCode           string                 `url:"country_code,omitempty" json:"country_code,omitempty"`
-	DanishIdentityNumber  string                 `url:"danish_identity_number,omitempty" json:"danish_identity_number,omitempty"`
-	Email                 string                 `url:"email,omitempty" json:"email,omitempty"`
-	FamilyName            string                 `url:"family_name,omitempty" json:"family_name,omitempty"`
-	GivenName             string                 `url:"given_name,omitempty" json:"given_name,omitempty"`
-	Locale                string                 `url:"locale,omitempty" json:"locale,omitempty"`
-	Metadata              map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	PostalCode            string                 `url:"postal_code,omitempty" json:"postal_code,omitempty"`
-	Region                string                 `url:"region,omitempty" json:"region,omitempty"`
-	SwedishIdentityNumber string                 `url:"swedish_identity_number,omitempty" json:"swedish_identity_number,omitempty"`
+	AddressLine1          *OptionalString   `url:"address_line1,omitempty" json:"address_line1,omitempty"`
+	AddressLine2          *OptionalString   `url:"address_line2,omitempty" json:"address_line2,omitempty"`
+	AddressLine3          *OptionalString   `url:"address_line3,omitempty" json:"address_line3,omitempty"`
+	City                  *OptionalString   `url:"city,omitempty" json:"city,omitempty"`
+	CompanyName           *OptionalString   `url:"company_name,omitempty" json:"company_name,omitempty"`
+	CountryCode           *OptionalString   `url:"country_code,omitempty" json:"country_code,omitempty"`
+	DanishIdentityNumber  *OptionalString   `url:"danish_identity_number,omitempty" json:"danish_identity_number,omitempty"`
+	Email                 *OptionalString   `url:"email,omitempty" json:"email,omitempty"`
+	FamilyName            *OptionalString   `url:"family_name,omitempty" json:"family_name,omitempty"`
+	GivenName             *OptionalString   `url:"given_name,omitempty" json:"given_name,omitempty"`
+	Locale                *OptionalString   `url:"locale,omitempty" json:"locale,omitempty"`
+	Metadata              *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
+	PostalCode            *OptionalString   `url:"postal_code,omitempty" json:"postal_code,omitempty"`
+	Region                *OptionalString   `url:"region,omitempty" json:"region,omitempty"`
+	SwedishIdentityNumber *OptionalString   `url:"swedish_identity_number,omitempty" json:"swedish_identity_number,omitempty"`
 }
 
 type PayerAuthorisationUpdateParamsMandate struct {
-	Metadata       map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	PayerIpAddress string                 `url:"payer_ip_address,omitempty" json:"payer_ip_address,omitempty"`
-	Reference      string                 `url:"reference,omitempty" json:"reference,omitempty"`
-	Scheme         string                 `url:"scheme,omitempty" json:"scheme,omitempty"`
+	Metadata       *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
+	PayerIpAddress *OptionalString   `url:"payer_ip_address,omitempty" json:"payer_ip_address,omitempty"`
+	Reference      *OptionalString   `url:"reference,omitempty" json:"reference,omitempty"`
+	Scheme         *OptionalString   `url:"scheme,omitempty" json:"scheme,omitempty"`
 }
 
 // PayerAuthorisationUpdateParams parameters
@@ -376,6 +387,10 @@ type PayerAuthorisationUpdateParams struct {
 // it must be sent completely as it overrides the previously existing values.
 // </p>
 func (s *PayerAuthorisationServiceImpl) Update(ctx context.Context, identity string, p PayerAuthorisationUpdateParams, opts ...RequestOption) (*PayerAuthorisation, error) {
+	if err := validateParams("payer_authorisations", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/payer_authorisations/%v",
 		identity))
 	if err != nil {
@@ -24,35 +24,39 @@ type PaymentServiceImpl struct {
 }
 
 type PaymentFx struct {
-	EstimatedExchangeRate string `url:"estimated_exchange_rate,omitempty" json:"estimated_exchange_rate,omitempty"`
-	ExchangeRate          string `url:"exchange_rate,omitempty" json:"exchange_rate,omitempty"`
-	FxAmount              int    `url:"fx_amount,omitempty" json:"fx_amount,omitempty"`
-	FxCurrency            string `url:"fx_currency,omitempty" json:"fx_currency,omitempty"`
+	EstimatedExchangeRate string                     `url:"estimated_exchange_rate,omitempty" json:"estimated_exchange_rate,omitempty"`
+	ExchangeRate          string                     `url:"exchange_rate,omitempty" json:"exchange_rate,omitempty"`
+	FxAmount              int                        `url:"fx_amount,omitempty" json:"fx_amount,omitempty"`
+	FxCurrency            string                     `url:"fx_currency,omitempty" json:"fx_currency,omitempty"`
+	Extra                 map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type PaymentLinks struct {
-	Creditor           string `url:"creditor,omitempty" json:"creditor,omitempty"`
-	InstalmentSchedule string `url:"instalment_schedule,omitempty" json:"instalment_schedule,omitempty"`
-	Mandate            string `url:"mandate,omitempty" json:"mandate,omitempty"`
-	Payout             string `url:"payout,omitempty" json:"payout,omitempty"`
-	Subscription       string `url:"subscription,omitempty" json:"subscription,omitempty"`
+	Creditor           string                     `url:"creditor,omitempty" json:"creditor,omitempty"`
+	InstalmentSchedule string                     `url:"instalment_schedule,omitempty" json:"instalment_schedule,omitempty"`
+	Mandate            string                     `url:"mandate,omitempty" json:"mandate,omitempty"`
+	Payout             string                     `url:"payout,omitempty" json:"payout,omitempty"`
+	Subscription       string                     `url:"subscription,omitempty" json:"subscription,omitempty"`
+	Extra              map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 // Payment model
 type Payment struct {
-	Amount          int                    `url:"amount,omitempty" json:"amount,omitempty"`
-	AmountRefunded  int                    `url:"amount_refunded,omitempty" json:"amount_refunded,omitempty"`
-	ChargeDate      string                 `url:"charge_date,omitempty" json:"charge_date,omitempty"`
-	CreatedAt       string                 `url:"created_at,omitempty" json:"created_at,omitempty"`
-	Currency        string                 `url:"currency,omitempty" json:"currency,omitempty"`
-	Description     string                 `url:"description,omitempty" json:"description,omitempty"`
-	Fx              *PaymentFx             `url:"fx,omitempty" json:"fx,omitempty"`
-	Id              string                 `url:"id,omitempty" json:"id,omitempty"`
-	Links           *PaymentLinks          `url:"links,omitempty" json:"links,omitempty"`
-	Metadata        map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	Reference       string                 `url:"reference,omitempty" json:"reference,omitempty"`
-	RetryIfPossible bool                   `url:"retry_if_possible,omitempty" json:"retry_if_possible,omitempty"`
-	Status          string                 `url:"status,omitempty" json:"status,omitempty"`
+	Amount          int                        `url:"amount,omitempty" json:"amount,omitempty"`
+	AmountRefunded  int                        `url:"amount_refunded,omitempty" json:"amount_refunded,omitempty"`
+	ChargeDate      *Date                      `url:"charge_date,omitempty" json:"charge_date,omitempty"`
+	CreatedAt       *Timestamp                 `url:"created_at,omitempty" json:"created_at,omitempty"`
+	Currency        string                     `url:"currency,omitempty" json:"currency,omitempty"`
+	Description     string                     `url:"description,omitempty" json:"description,omitempty"`
+	Fx              *PaymentFx                 `url:"fx,omitempty" json:"fx,omitempty"`
+	Id              string                     `url:"id,omitempty" json:"id,omitempty"`
+	Links           *PaymentLinks              `url:"links,omitempty" json:"links,omitempty"`
+	Metadata        Metadata                   `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Reference       string                     `url:"reference,omitempty" json:"reference,omitempty"`
+	RetryIfPossible bool                       `url:"retry_if_possible,omitempty" json:"retry_if_possible,omitempty"`
+	Status          PaymentStatus              `url:"status,omitempty" json:"status,omitempty"`
+	Extra           map[string]json.RawMessage `url:"-" json:"-"`
+	raw             json.RawMessage
 }
 
 type PaymentService interface {
@@ -73,11 +77,11 @@ type PaymentCreateParamsLinks struct {
 type PaymentCreateParams struct {
 	Amount          int                      `url:"amount,omitempty" json:"amount,omitempty"`
 	AppFee          int                      `url:"app_fee,omitempty" json:"app_fee,omitempty"`
-	ChargeDate      string                   `url:"charge_date,omitempty" json:"charge_date,omitempty"`
+	ChargeDate      *Date                    `url:"charge_date,omitempty" json:"charge_date,omitempty"`
 	Currency        string                   `url:"currency,omitempty" json:"currency,omitempty"`
 	Description     string                   `url:"description,omitempty" json:"description,omitempty"`
 	Links           PaymentCreateParamsLinks `url:"links,omitempty" json:"links,omitempty"`
-	Metadata        map[string]interface{}   `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata        Metadata                 `url:"metadata,omitempty" json:"metadata,omitempty"`
 	Reference       string                   `url:"reference,omitempty" json:"reference,omitempty"`
 	RetryIfPossible bool                     `url:"retry_if_possible,omitempty" json:"retry_if_possible,omitempty"`
 }
@@ -90,6 +94,10 @@ type PaymentCreateParams struct {
 // be created against mandates with status of: `pending_customer_approval`,
 // `pending_submission`, `submitted`, and `active`.
 func (s *PaymentServiceImpl) Create(ctx context.Context, p PaymentCreateParams, opts ...RequestOption) (*Payment, error) {
+	if err := validateParams("payments", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/payments"))
 	if err != nil {
 		return nil, err
@@ -180,19 +188,9 @@ func (s *PaymentServiceImpl) Create(ctx context.Context, p PaymentCreateParams,
 	return result.Payment, nil
 }
 
-type PaymentListParamsChargeDate struct {
-	Gt  string `url:"gt,omitempty" json:"gt,omitempty"`
-	Gte string `url:"gte,omitempty" json:"gte,omitempty"`
-	Lt  string `url:"lt,omitempty" json:"lt,omitempty"`
-	Lte string `url:"lte,omitempty" json:"lte,omitempty"`
-}
+type PaymentListParamsChargeDate = DateRange
 
-type PaymentListParamsCreatedAt struct {
-	Gt  string `url:"gt,omitempty" json:"gt,omitempty"`
-	Gte string `url:"gte,omitempty" json:"gte,omitempty"`
-	Lt  string `url:"lt,omitempty" json:"lt,omitempty"`
-	Lte string `url:"lte,omitempty" json:"lte,omitempty"`
-}
+type PaymentListParamsCreatedAt = TimestampRange
 
 // PaymentListParams parameters
 type PaymentListParams struct {
@@ -207,7 +205,7 @@ type PaymentListParams struct {
 	Mandate       string                       `url:"mandate,omitempty" json:"mandate,omitempty"`
 	SortDirection string                       `url:"sort_direction,omitempty" json:"sort_direction,omitempty"`
 	SortField     string                       `url:"sort_field,omitempty" json:"sort_field,omitempty"`
-	Status        string                       `url:"status,omitempty" json:"status,omitempty"`
+	Status        PaymentStatus                `url:"status,omitempty" json:"status,omitempty"`
 	Subscription  string                       `url:"subscription,omitempty" json:"subscription,omitempty"`
 }
 
@@ -515,13 +513,17 @@ func (s *PaymentServiceImpl) Get(ctx context.Context, identity string, opts ...R
 
 // PaymentUpdateParams parameters
 type PaymentUpdateParams struct {
-	Metadata        map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	RetryIfPossible bool                   `url:"retry_if_possible,omitempty" json:"retry_if_possible,omitempty"`
+	Metadata        *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
+	RetryIfPossible *OptionalBool     `url:"retry_if_possible,omitempty" json:"retry_if_possible,omitempty"`
 }
 
 // Update
 // Updates a payment object. This accepts only the metadata parameter.
 func (s *PaymentServiceImpl) Update(ctx context.Context, identity string, p PaymentUpdateParams, opts ...RequestOption) (*Payment, error) {
+	if err := validateParams("payments", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/payments/%v",
 		identity))
 	if err != nil {
@@ -615,7 +617,7 @@ func (s *PaymentServiceImpl) Update(ctx context.Context, identity string, p Paym
 
 // PaymentCancelParams parameters
 type PaymentCancelParams struct {
-	Metadata map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 // Cancel
@@ -626,6 +628,10 @@ type PaymentCancelParams struct {
 // This will fail with a `cancellation_failed` error unless the payment's status
 // is `pending_submission`.
 func (s *PaymentServiceImpl) Cancel(ctx context.Context, identity string, p PaymentCancelParams, opts ...RequestOption) (*Payment, error) {
+	if err := validateParams("data", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/payments/%v/actions/cancel",
 		identity))
 	if err != nil {
@@ -719,8 +725,8 @@ func (s *PaymentServiceImpl) Cancel(ctx context.Context, identity string, p Paym
 
 // PaymentRetryParams parameters
 type PaymentRetryParams struct {
-	ChargeDate string                 `url:"charge_date,omitempty" json:"charge_date,omitempty"`
-	Metadata   map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
+	ChargeDate *Date             `url:"charge_date,omitempty" json:"charge_date,omitempty"`
+	Metadata   *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 // Retry
@@ -735,6 +741,10 @@ type PaymentRetryParams struct {
 //
 // Payments can be retried up to 3 times.
 func (s *PaymentServiceImpl) Retry(ctx context.Context, identity string, p PaymentRetryParams, opts ...RequestOption) (*Payment, error) {
+	if err := validateParams("data", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/payments/%v/actions/retry",
 		identity))
 	if err != nil {
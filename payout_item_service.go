@@ -24,26 +24,30 @@ type PayoutItemServiceImpl struct {
 }
 
 type PayoutItemLinks struct {
-	Mandate string `url:"mandate,omitempty" json:"mandate,omitempty"`
-	Payment string `url:"payment,omitempty" json:"payment,omitempty"`
-	Refund  string `url:"refund,omitempty" json:"refund,omitempty"`
+	Mandate string                     `url:"mandate,omitempty" json:"mandate,omitempty"`
+	Payment string                     `url:"payment,omitempty" json:"payment,omitempty"`
+	Refund  string                     `url:"refund,omitempty" json:"refund,omitempty"`
+	Extra   map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type PayoutItemTaxes struct {
-	Amount              string `url:"amount,omitempty" json:"amount,omitempty"`
-	Currency            string `url:"currency,omitempty" json:"currency,omitempty"`
-	DestinationAmount   string `url:"destination_amount,omitempty" json:"destination_amount,omitempty"`
-	DestinationCurrency string `url:"destination_currency,omitempty" json:"destination_currency,omitempty"`
-	ExchangeRate        string `url:"exchange_rate,omitempty" json:"exchange_rate,omitempty"`
-	TaxRateId           string `url:"tax_rate_id,omitempty" json:"tax_rate_id,omitempty"`
+	Amount              string                     `url:"amount,omitempty" json:"amount,omitempty"`
+	Currency            string                     `url:"currency,omitempty" json:"currency,omitempty"`
+	DestinationAmount   string                     `url:"destination_amount,omitempty" json:"destination_amount,omitempty"`
+	DestinationCurrency string                     `url:"destination_currency,omitempty" json:"destination_currency,omitempty"`
+	ExchangeRate        string                     `url:"exchange_rate,omitempty" json:"exchange_rate,omitempty"`
+	TaxRateId           string                     `url:"tax_rate_id,omitempty" json:"tax_rate_id,omitempty"`
+	Extra               map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 // PayoutItem model
 type PayoutItem struct {
-	Amount string            `url:"amount,omitempty" json:"amount,omitempty"`
-	Links  *PayoutItemLinks  `url:"links,omitempty" json:"links,omitempty"`
-	Taxes  []PayoutItemTaxes `url:"taxes,omitempty" json:"taxes,omitempty"`
-	Type   string            `url:"type,omitempty" json:"type,omitempty"`
+	Amount string                     `url:"amount,omitempty" json:"amount,omitempty"`
+	Links  *PayoutItemLinks           `url:"links,omitempty" json:"links,omitempty"`
+	Taxes  []PayoutItemTaxes          `url:"taxes,omitempty" json:"taxes,omitempty"`
+	Type   string                     `url:"type,omitempty" json:"type,omitempty"`
+	Extra  map[string]json.RawMessage `url:"-" json:"-"`
+	raw    json.RawMessage
 }
 
 type PayoutItemService interface {
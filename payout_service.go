@@ -24,32 +24,36 @@ type PayoutServiceImpl struct {
 }
 
 type PayoutFx struct {
-	EstimatedExchangeRate string `url:"estimated_exchange_rate,omitempty" json:"estimated_exchange_rate,omitempty"`
-	ExchangeRate          string `url:"exchange_rate,omitempty" json:"exchange_rate,omitempty"`
-	FxAmount              int    `url:"fx_amount,omitempty" json:"fx_amount,omitempty"`
-	FxCurrency            string `url:"fx_currency,omitempty" json:"fx_currency,omitempty"`
+	EstimatedExchangeRate string                     `url:"estimated_exchange_rate,omitempty" json:"estimated_exchange_rate,omitempty"`
+	ExchangeRate          string                     `url:"exchange_rate,omitempty" json:"exchange_rate,omitempty"`
+	FxAmount              int                        `url:"fx_amount,omitempty" json:"fx_amount,omitempty"`
+	FxCurrency            string                     `url:"fx_currency,omitempty" json:"fx_currency,omitempty"`
+	Extra                 map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type PayoutLinks struct {
-	Creditor            string `url:"creditor,omitempty" json:"creditor,omitempty"`
-	CreditorBankAccount string `url:"creditor_bank_account,omitempty" json:"creditor_bank_account,omitempty"`
+	Creditor            string                     `url:"creditor,omitempty" json:"creditor,omitempty"`
+	CreditorBankAccount string                     `url:"creditor_bank_account,omitempty" json:"creditor_bank_account,omitempty"`
+	Extra               map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 // Payout model
 type Payout struct {
-	Amount       int                    `url:"amount,omitempty" json:"amount,omitempty"`
-	ArrivalDate  string                 `url:"arrival_date,omitempty" json:"arrival_date,omitempty"`
-	CreatedAt    string                 `url:"created_at,omitempty" json:"created_at,omitempty"`
-	Currency     string                 `url:"currency,omitempty" json:"currency,omitempty"`
-	DeductedFees int                    `url:"deducted_fees,omitempty" json:"deducted_fees,omitempty"`
-	Fx           *PayoutFx              `url:"fx,omitempty" json:"fx,omitempty"`
-	Id           string                 `url:"id,omitempty" json:"id,omitempty"`
-	Links        *PayoutLinks           `url:"links,omitempty" json:"links,omitempty"`
-	Metadata     map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	PayoutType   string                 `url:"payout_type,omitempty" json:"payout_type,omitempty"`
-	Reference    string                 `url:"reference,omitempty" json:"reference,omitempty"`
-	Status       string                 `url:"status,omitempty" json:"status,omitempty"`
-	TaxCurrency  string                 `url:"tax_currency,omitempty" json:"tax_currency,omitempty"`
+	Amount       int                        `url:"amount,omitempty" json:"amount,omitempty"`
+	ArrivalDate  *Date                      `url:"arrival_date,omitempty" json:"arrival_date,omitempty"`
+	CreatedAt    *Timestamp                 `url:"created_at,omitempty" json:"created_at,omitempty"`
+	Currency     string                     `url:"currency,omitempty" json:"currency,omitempty"`
+	DeductedFees int                        `url:"deducted_fees,omitempty" json:"deducted_fees,omitempty"`
+	Fx           *PayoutFx                  `url:"fx,omitempty" json:"fx,omitempty"`
+	Id           string                     `url:"id,omitempty" json:"id,omitempty"`
+	Links        *PayoutLinks               `url:"links,omitempty" json:"links,omitempty"`
+	Metadata     Metadata                   `url:"metadata,omitempty" json:"metadata,omitempty"`
+	PayoutType   string                     `url:"payout_type,omitempty" json:"payout_type,omitempty"`
+	Reference    string                     `url:"reference,omitempty" json:"reference,omitempty"`
+	Status       PayoutStatus               `url:"status,omitempty" json:"status,omitempty"`
+	TaxCurrency  string                     `url:"tax_currency,omitempty" json:"tax_currency,omitempty"`
+	Extra        map[string]json.RawMessage `url:"-" json:"-"`
+	raw          json.RawMessage
 }
 
 type PayoutService interface {
@@ -59,12 +63,7 @@ type PayoutService interface {
 	Update(ctx context.Context, identity string, p PayoutUpdateParams, opts ...RequestOption) (*Payout, error)
 }
 
-type PayoutListParamsCreatedAt struct {
-	Gt  string `url:"gt,omitempty" json:"gt,omitempty"`
-	Gte string `url:"gte,omitempty" json:"gte,omitempty"`
-	Lt  string `url:"lt,omitempty" json:"lt,omitempty"`
-	Lte string `url:"lte,omitempty" json:"lte,omitempty"`
-}
+type PayoutListParamsCreatedAt = TimestampRange
 
 // PayoutListParams parameters
 type PayoutListParams struct {
@@ -75,10 +74,10 @@ type PayoutListParams struct {
 	CreditorBankAccount string                     `url:"creditor_bank_account,omitempty" json:"creditor_bank_account,omitempty"`
 	Currency            string                     `url:"currency,omitempty" json:"currency,omitempty"`
 	Limit               int                        `url:"limit,omitempty" json:"limit,omitempty"`
-	Metadata            map[string]interface{}     `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata            Metadata                   `url:"metadata,omitempty" json:"metadata,omitempty"`
 	PayoutType          string                     `url:"payout_type,omitempty" json:"payout_type,omitempty"`
 	Reference           string                     `url:"reference,omitempty" json:"reference,omitempty"`
-	Status              string                     `url:"status,omitempty" json:"status,omitempty"`
+	Status              PayoutStatus               `url:"status,omitempty" json:"status,omitempty"`
 }
 
 type PayoutListResultMetaCursors struct {
@@ -100,6 +99,10 @@ type PayoutListResult struct {
 // Returns a [cursor-paginated](#api-usage-cursor-pagination) list of your
 // payouts.
 func (s *PayoutServiceImpl) List(ctx context.Context, p PayoutListParams, opts ...RequestOption) (*PayoutListResult, error) {
+	if err := validateParams("payouts", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/payouts"))
 	if err != nil {
 		return nil, err
@@ -387,12 +390,16 @@ func (s *PayoutServiceImpl) Get(ctx context.Context, identity string, opts ...Re
 
 // PayoutUpdateParams parameters
 type PayoutUpdateParams struct {
-	Metadata map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 // Update
 // Updates a payout object. This accepts only the metadata parameter.
 func (s *PayoutServiceImpl) Update(ctx context.Context, identity string, p PayoutUpdateParams, opts ...RequestOption) (*Payout, error) {
+	if err := validateParams("payouts", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/payouts/%v",
 		identity))
 	if err != nil {
@@ -0,0 +1,317 @@
+// Package reconcile matches the items of a payout to the payments, refunds
+// and fees making it up, and checks they add up to the amount paid out.
+//
+// Fee items have negative amounts, and the amount of a payout is what was
+// paid out, net of its deducted fees. The items, fees included, therefore
+// add up to Payout.Amount rather than to Amount minus DeductedFees, while
+// the fee items add up to DeductedFees.
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gocardless "github.com/gocardless/gocardless-pro-go/v2"
+)
+
+// ItemType is the type of a payout item.
+type ItemType string
+
+const (
+	ItemPaymentPaidOut      ItemType = "payment_paid_out"
+	ItemPaymentFailed       ItemType = "payment_failed"
+	ItemPaymentChargedBack  ItemType = "payment_charged_back"
+	ItemPaymentRefunded     ItemType = "payment_refunded"
+	ItemRefund              ItemType = "refund"
+	ItemRefundFundsReturned ItemType = "refund_funds_returned"
+	ItemGocardlessFee       ItemType = "gocardless_fee"
+	ItemAppFee              ItemType = "app_fee"
+	ItemRevenueShare        ItemType = "revenue_share"
+	ItemSurchargeFee        ItemType = "surcharge_fee"
+)
+
+// IsValid reports whether t is a documented payout item type.
+func (t ItemType) IsValid() bool {
+	switch t {
+	case ItemPaymentPaidOut, ItemPaymentFailed, ItemPaymentChargedBack, ItemPaymentRefunded,
+		ItemRefund, ItemRefundFundsReturned,
+		ItemGocardlessFee, ItemAppFee, ItemRevenueShare, ItemSurchargeFee:
+		return true
+	}
+	return false
+}
+
+// IsFee reports whether items of type t are fees deducted from the payout.
+func (t ItemType) IsFee() bool {
+	switch t {
+	case ItemGocardlessFee, ItemAppFee, ItemRevenueShare, ItemSurchargeFee:
+		return true
+	}
+	return false
+}
+
+// linksPayment reports whether items of type t must link to a payment.
+func (t ItemType) linksPayment() bool {
+	switch t {
+	case ItemPaymentPaidOut, ItemPaymentFailed, ItemPaymentChargedBack, ItemPaymentRefunded:
+		return true
+	}
+	return false
+}
+
+// linksRefund reports whether items of type t must link to a refund.
+func (t ItemType) linksRefund() bool {
+	return t == ItemRefund || t == ItemRefundFundsReturned
+}
+
+// FindingKind is the kind of a discrepancy found while reconciling.
+type FindingKind string
+
+const (
+	// FindingAmountMismatch means the items, including the negative fee
+	// items, don't add up to the amount of the payout, which is net of its
+	// deducted fees.
+	FindingAmountMismatch FindingKind = "amount_mismatch"
+	// FindingFeesMismatch means the fee items don't add up to the deducted
+	// fees of the payout.
+	FindingFeesMismatch FindingKind = "fees_mismatch"
+	// FindingUnknownType means an item has a type this package doesn't
+	// know.
+	FindingUnknownType FindingKind = "unknown_type"
+	// FindingMissingLink means an item doesn't link to the payment or
+	// refund it is about.
+	FindingMissingLink FindingKind = "missing_link"
+	// FindingInvalidAmount means an item or tax amount can't be parsed.
+	FindingInvalidAmount FindingKind = "invalid_amount"
+	// FindingTaxCurrencyMismatch means a tax isn't in the tax currency of
+	// the payout, and was left out of the total taxes.
+	FindingTaxCurrencyMismatch FindingKind = "tax_currency_mismatch"
+)
+
+// Finding is a discrepancy found while reconciling.
+type Finding struct {
+	Kind FindingKind
+	// Item is the index of the item the finding is about, or -1 for the
+	// payout.
+	Item    int
+	Message string
+	// Expected and Actual are set for mismatched amounts.
+	Expected *gocardless.Money
+	Actual   *gocardless.Money
+}
+
+func (f Finding) String() string {
+	if f.Item < 0 {
+		return fmt.Sprintf("%s: %s", f.Kind, f.Message)
+	}
+	return fmt.Sprintf("%s: item %d: %s", f.Kind, f.Item, f.Message)
+}
+
+// Item is a classified payout item.
+type Item struct {
+	// Raw is the item as listed by the API.
+	Raw  gocardless.PayoutItem
+	Type ItemType
+	// Amount is the signed amount of the item in the payout currency.
+	Amount gocardless.Money
+	// Taxes is the total of the taxes of the item in the tax currency of
+	// the payout.
+	Taxes gocardless.Money
+}
+
+// Report is the outcome of reconciling a payout.
+type Report struct {
+	Payout *gocardless.Payout
+	Items  []Item
+	// Totals holds the total amount of the items of each type.
+	Totals map[ItemType]gocardless.Money
+	// Payments and Refunds link the IDs of payments and refunds to the
+	// indexes of the items about them.
+	Payments map[string][]int
+	Refunds  map[string][]int
+	// Gross is the total of the items which aren't fees, Fees the total of
+	// the fee items, which is negative, and Net their sum.
+	Gross, Fees, Net gocardless.Money
+	// Taxes is the total of the taxes in the tax currency of the payout.
+	Taxes    gocardless.Money
+	Findings []Finding
+}
+
+// OK reports whether the payout reconciled without findings.
+func (r *Report) OK() bool {
+	return len(r.Findings) == 0
+}
+
+// Reconcile lists the items of payout page by page, reconciling them as
+// ReconcileItems does.
+func Reconcile(ctx context.Context, s *gocardless.Service, payout *gocardless.Payout, opts ...gocardless.RequestOption) (*Report, error) {
+	if s == nil {
+		return nil, errors.New("missing service")
+	}
+	if payout == nil {
+		return nil, errors.New("missing payout")
+	}
+
+	r := newReport(payout)
+	p := gocardless.PayoutItemListParams{Payout: payout.Id, Limit: 500}
+	for {
+		res, err := s.PayoutItems.List(ctx, p, opts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range res.PayoutItems {
+			r.add(item)
+		}
+		if res.Meta.Cursors == nil || res.Meta.Cursors.After == "" || len(res.PayoutItems) == 0 {
+			break
+		}
+		p.After = res.Meta.Cursors.After
+	}
+	r.check()
+	return r, nil
+}
+
+// ReconcileItems classifies the items of payout, links them to payments and
+// refunds and checks that their net total is the amount of the payout, which
+// is paid out net of its deducted fees.
+func ReconcileItems(payout *gocardless.Payout, items []gocardless.PayoutItem) *Report {
+	r := newReport(payout)
+	for _, item := range items {
+		r.add(item)
+	}
+	r.check()
+	return r
+}
+
+func newReport(payout *gocardless.Payout) *Report {
+	currency := gocardless.Currency(payout.Currency)
+	taxCurrency := gocardless.Currency(payout.TaxCurrency)
+	if taxCurrency == "" {
+		taxCurrency = currency
+	}
+	return &Report{
+		Payout:   payout,
+		Totals:   map[ItemType]gocardless.Money{},
+		Payments: map[string][]int{},
+		Refunds:  map[string][]int{},
+		Gross:    gocardless.NewMoney(0, currency),
+		Fees:     gocardless.NewMoney(0, currency),
+		Net:      gocardless.NewMoney(0, currency),
+		Taxes:    gocardless.NewMoney(0, taxCurrency),
+	}
+}
+
+func (r *Report) finding(kind FindingKind, item int, format string, args ...interface{}) {
+	r.Findings = append(r.Findings, Finding{Kind: kind, Item: item, Message: fmt.Sprintf(format, args...)})
+}
+
+// add classifies item and adds it to the totals.
+func (r *Report) add(item gocardless.PayoutItem) {
+	i := len(r.Items)
+	it := Item{
+		Raw:    item,
+		Type:   ItemType(item.Type),
+		Amount: gocardless.NewMoney(0, r.Net.Currency),
+		Taxes:  gocardless.NewMoney(0, r.Taxes.Currency),
+	}
+
+	if !it.Type.IsValid() {
+		r.finding(FindingUnknownType, i, "unknown type %q", item.Type)
+	}
+	var links gocardless.PayoutItemLinks
+	if item.Links != nil {
+		links = *item.Links
+	}
+	if links.Payment != "" {
+		r.Payments[links.Payment] = append(r.Payments[links.Payment], i)
+	} else if it.Type.linksPayment() {
+		r.finding(FindingMissingLink, i, "%s item doesn't link to a payment", it.Type)
+	}
+	if links.Refund != "" {
+		r.Refunds[links.Refund] = append(r.Refunds[links.Refund], i)
+	} else if it.Type.linksRefund() {
+		r.finding(FindingMissingLink, i, "%s item doesn't link to a refund", it.Type)
+	}
+
+	if amount, err := item.AmountMoney(r.Net.Currency); err != nil {
+		r.finding(FindingInvalidAmount, i, "%v", err)
+	} else {
+		it.Amount = amount
+		total := r.totalOf(it.Type)
+		r.sum(i, &total, amount)
+		r.Totals[it.Type] = total
+		r.sum(i, &r.Net, amount)
+		if it.Type.IsFee() {
+			r.sum(i, &r.Fees, amount)
+		} else {
+			r.sum(i, &r.Gross, amount)
+		}
+	}
+
+	for _, tax := range item.Taxes {
+		amount, err := taxAmount(tax)
+		switch {
+		case err != nil:
+			r.finding(FindingInvalidAmount, i, "tax %s: %v", tax.TaxRateId, err)
+		case amount.Currency != r.Taxes.Currency:
+			r.finding(FindingTaxCurrencyMismatch, i, "tax %s is in %s rather than %s", tax.TaxRateId, amount.Currency, r.Taxes.Currency)
+		default:
+			r.sum(i, &it.Taxes, amount)
+			r.sum(i, &r.Taxes, amount)
+		}
+	}
+	r.Items = append(r.Items, it)
+}
+
+func (r *Report) totalOf(t ItemType) gocardless.Money {
+	if total, ok := r.Totals[t]; ok {
+		return total
+	}
+	return gocardless.NewMoney(0, r.Net.Currency)
+}
+
+// taxAmount returns the amount of tax in the currency it was paid in,
+// falling back to the currency it was charged in.
+func taxAmount(tax gocardless.PayoutItemTaxes) (gocardless.Money, error) {
+	if tax.DestinationAmount != "" {
+		return tax.DestinationAmountMoney()
+	}
+	return tax.AmountMoney()
+}
+
+// sum adds amount to total, recording a finding about the item if it
+// overflows.
+func (r *Report) sum(item int, total *gocardless.Money, amount gocardless.Money) {
+	sum, err := total.Add(amount)
+	if err != nil {
+		r.finding(FindingInvalidAmount, item, "%v", err)
+		return
+	}
+	*total = sum
+}
+
+// check compares the totals with the payout.
+func (r *Report) check() {
+	amount, net := r.Payout.AmountMoney(), r.Net
+	if net != amount {
+		r.Findings = append(r.Findings, Finding{
+			Kind:     FindingAmountMismatch,
+			Item:     -1,
+			Message:  fmt.Sprintf("items add up to %s rather than the payout amount of %s", r.Net, amount),
+			Expected: &amount,
+			Actual:   &net,
+		})
+	}
+
+	fees, actual := r.Payout.DeductedFeesMoney().Neg(), r.Fees
+	if actual != fees {
+		r.Findings = append(r.Findings, Finding{
+			Kind:     FindingFeesMismatch,
+			Item:     -1,
+			Message:  fmt.Sprintf("fee items add up to %s rather than the deducted fees of %s", actual.Neg(), fees.Neg()),
+			Expected: &fees,
+			Actual:   &actual,
+		})
+	}
+}
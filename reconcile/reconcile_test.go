@@ -0,0 +1,169 @@
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	gocardless "github.com/gocardless/gocardless-pro-go/v2"
+)
+
+type fakePayoutItemService struct {
+	gocardless.PayoutItemService
+	pages [][]gocardless.PayoutItem
+}
+
+func (s *fakePayoutItemService) List(ctx context.Context, p gocardless.PayoutItemListParams, opts ...gocardless.RequestOption) (*gocardless.PayoutItemListResult, error) {
+	page := 0
+	if p.After != "" {
+		page = int(p.After[0] - '0')
+	}
+	res := &gocardless.PayoutItemListResult{PayoutItems: s.pages[page]}
+	if page+1 < len(s.pages) {
+		res.Meta.Cursors = &gocardless.PayoutItemListResultMetaCursors{After: string(rune('0' + page + 1))}
+	}
+	return res, nil
+}
+
+func item(typ, amount, payment, refund string, taxes ...gocardless.PayoutItemTaxes) gocardless.PayoutItem {
+	return gocardless.PayoutItem{
+		Type:   typ,
+		Amount: amount,
+		Links:  &gocardless.PayoutItemLinks{Payment: payment, Refund: refund},
+		Taxes:  taxes,
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	payout := &gocardless.Payout{Id: "PO123", Amount: 2780, DeductedFees: 220, Currency: "GBP", TaxCurrency: "GBP"}
+	vat := gocardless.PayoutItemTaxes{Amount: "0.33", Currency: "GBP", DestinationAmount: "0.33", DestinationCurrency: "GBP", TaxRateId: "GB_VAT_1"}
+	s := &gocardless.Service{PayoutItems: &fakePayoutItemService{pages: [][]gocardless.PayoutItem{
+		{
+			item("payment_paid_out", "20.00", "PM1", ""),
+			item("gocardless_fee", "-2.00", "PM1", "", vat),
+		},
+		{
+			item("payment_paid_out", "10.00", "PM2", ""),
+			item("app_fee", "-0.20", "PM2", ""),
+		},
+	}}}
+
+	r, err := Reconcile(context.TODO(), s, payout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.OK() {
+		t.Fatalf("Unexpected findings %v", r.Findings)
+	}
+	if len(r.Items) != 4 || len(r.Payments["PM1"]) != 2 {
+		t.Fatalf("Unexpected items %v", r.Items)
+	}
+	if r.Gross.Amount != 3000 || r.Fees.Amount != -220 || r.Taxes.Amount != 33 {
+		t.Fatalf("Unexpected totals %v, %v, %v", r.Gross, r.Fees, r.Taxes)
+	}
+	if r.Totals[ItemPaymentPaidOut].Amount != 3000 {
+		t.Fatalf("Expected %d, got %d", 3000, r.Totals[ItemPaymentPaidOut].Amount)
+	}
+}
+
+func TestReconcileNetOfDeductedFees(t *testing.T) {
+	items := []gocardless.PayoutItem{
+		item("payment_paid_out", "100.00", "PM1", ""),
+		item("gocardless_fee", "-4.00", "PM1", ""),
+		item("surcharge_fee", "-1.00", "PM1", ""),
+	}
+	r := ReconcileItems(&gocardless.Payout{Amount: 9500, DeductedFees: 500, Currency: "GBP"}, items)
+	if !r.OK() {
+		t.Fatalf("Unexpected findings %v", r.Findings)
+	}
+	if r.Gross.Amount != 10000 || r.Fees.Amount != -500 || r.Net.Amount != 9500 {
+		t.Fatalf("Unexpected totals %v, %v, %v", r.Gross, r.Fees, r.Net)
+	}
+
+	// Taking the fees off the amount of the payout again doesn't reconcile.
+	r = ReconcileItems(&gocardless.Payout{Amount: 9000, DeductedFees: 500, Currency: "GBP"}, items)
+	if len(r.Findings) != 1 || r.Findings[0].Kind != FindingAmountMismatch {
+		t.Fatalf("Expected %s, got %v", FindingAmountMismatch, r.Findings)
+	}
+}
+
+func TestItemMarshalJSON(t *testing.T) {
+	r := ReconcileItems(&gocardless.Payout{Amount: 1000, Currency: "GBP"}, []gocardless.PayoutItem{
+		item("payment_paid_out", "10.00", "PM1", ""),
+	})
+	out, err := json.Marshal(r.Items[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]json.RawMessage
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatal(err)
+	}
+	for _, field := range []string{"Raw", "Type", "Amount", "Taxes"} {
+		if _, ok := got[field]; !ok {
+			t.Fatalf("Expected %s in %s", field, out)
+		}
+	}
+}
+
+func TestReconcileFindings(t *testing.T) {
+	payout := &gocardless.Payout{Amount: 1000, DeductedFees: 50, Currency: "GBP"}
+	r := ReconcileItems(payout, []gocardless.PayoutItem{
+		item("payment_paid_out", "10.00", "", ""),
+		item("refund", "-1.00", "PM1", ""),
+		item("bonus", "0.50", "", ""),
+		item("gocardless_fee", "abc", "", ""),
+		item("gocardless_fee", "-0.30", "", "", gocardless.PayoutItemTaxes{Amount: "0.06", Currency: "EUR", TaxRateId: "FR_VAT_1"}),
+	})
+
+	expected := []FindingKind{
+		FindingMissingLink,
+		FindingMissingLink,
+		FindingUnknownType,
+		FindingInvalidAmount,
+		FindingTaxCurrencyMismatch,
+		FindingAmountMismatch,
+		FindingFeesMismatch,
+	}
+	if len(r.Findings) != len(expected) {
+		t.Fatalf("Expected %d findings, got %v", len(expected), r.Findings)
+	}
+	for i, f := range r.Findings {
+		if f.Kind != expected[i] {
+			t.Fatalf("Expected %s, got %s", expected[i], f)
+		}
+	}
+	amountMismatch := r.Findings[5]
+	if amountMismatch.Item != -1 || amountMismatch.Expected.Amount != 1000 || amountMismatch.Actual.Amount != 920 {
+		t.Fatalf("Unexpected finding %+v", amountMismatch)
+	}
+	message := "fees_mismatch: fee items add up to 0.30 GBP rather than the deducted fees of 0.50 GBP"
+	if r.Findings[6].String() != message {
+		t.Fatalf("Expected %q, got %q", message, r.Findings[6].String())
+	}
+}
+
+func TestReconcileFixture(t *testing.T) {
+	data, err := os.ReadFile("../testdata/payout_items.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fixture struct {
+		List struct {
+			Body gocardless.PayoutItemListResult `json:"body"`
+		} `json:"list"`
+	}
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		t.Fatal(err)
+	}
+
+	payout := &gocardless.Payout{Amount: 9000, Currency: "EUR", TaxCurrency: "EUR"}
+	r := ReconcileItems(payout, fixture.List.Body.PayoutItems)
+	if !r.OK() {
+		t.Fatalf("Unexpected findings %v", r.Findings)
+	}
+	if r.Taxes.Amount != 220 || len(r.Refunds["RF123"]) != 2 {
+		t.Fatalf("Unexpected report %+v", r)
+	}
+}
@@ -24,26 +24,29 @@ type RedirectFlowServiceImpl struct {
 }
 
 type RedirectFlowLinks struct {
-	BillingRequest      string `url:"billing_request,omitempty" json:"billing_request,omitempty"`
-	Creditor            string `url:"creditor,omitempty" json:"creditor,omitempty"`
-	Customer            string `url:"customer,omitempty" json:"customer,omitempty"`
-	CustomerBankAccount string `url:"customer_bank_account,omitempty" json:"customer_bank_account,omitempty"`
-	Mandate             string `url:"mandate,omitempty" json:"mandate,omitempty"`
+	BillingRequest      string                     `url:"billing_request,omitempty" json:"billing_request,omitempty"`
+	Creditor            string                     `url:"creditor,omitempty" json:"creditor,omitempty"`
+	Customer            string                     `url:"customer,omitempty" json:"customer,omitempty"`
+	CustomerBankAccount string                     `url:"customer_bank_account,omitempty" json:"customer_bank_account,omitempty"`
+	Mandate             string                     `url:"mandate,omitempty" json:"mandate,omitempty"`
+	Extra               map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 // RedirectFlow model
 type RedirectFlow struct {
-	ConfirmationUrl    string                 `url:"confirmation_url,omitempty" json:"confirmation_url,omitempty"`
-	CreatedAt          string                 `url:"created_at,omitempty" json:"created_at,omitempty"`
-	Description        string                 `url:"description,omitempty" json:"description,omitempty"`
-	Id                 string                 `url:"id,omitempty" json:"id,omitempty"`
-	Links              *RedirectFlowLinks     `url:"links,omitempty" json:"links,omitempty"`
-	MandateReference   string                 `url:"mandate_reference,omitempty" json:"mandate_reference,omitempty"`
-	Metadata           map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	RedirectUrl        string                 `url:"redirect_url,omitempty" json:"redirect_url,omitempty"`
-	Scheme             string                 `url:"scheme,omitempty" json:"scheme,omitempty"`
-	SessionToken       string                 `url:"session_token,omitempty" json:"session_token,omitempty"`
-	SuccessRedirectUrl string                 `url:"success_redirect_url,omitempty" json:"success_redirect_url,omitempty"`
+	ConfirmationUrl    string                     `url:"confirmation_url,omitempty" json:"confirmation_url,omitempty"`
+	CreatedAt          *Timestamp                 `url:"created_at,omitempty" json:"created_at,omitempty"`
+	Description        string                     `url:"description,omitempty" json:"description,omitempty"`
+	Id                 string                     `url:"id,omitempty" json:"id,omitempty"`
+	Links              *RedirectFlowLinks         `url:"links,omitempty" json:"links,omitempty"`
+	MandateReference   string                     `url:"mandate_reference,omitempty" json:"mandate_reference,omitempty"`
+	Metadata           Metadata                   `url:"metadata,omitempty" json:"metadata,omitempty"`
+	RedirectUrl        string                     `url:"redirect_url,omitempty" json:"redirect_url,omitempty"`
+	Scheme             string                     `url:"scheme,omitempty" json:"scheme,omitempty"`
+	SessionToken       string                     `url:"session_token,omitempty" json:"session_token,omitempty"`
+	SuccessRedirectUrl string                     `url:"success_redirect_url,omitempty" json:"success_redirect_url,omitempty"`
+	Extra              map[string]json.RawMessage `url:"-" json:"-"`
+	raw                json.RawMessage
 }
 
 type RedirectFlowService interface {
@@ -82,7 +85,7 @@ type RedirectFlowCreateParamsPrefilledCustomer struct {
 type RedirectFlowCreateParams struct {
 	Description          string                                        `url:"description,omitempty" json:"description,omitempty"`
 	Links                *RedirectFlowCreateParamsLinks                `url:"links,omitempty" json:"links,omitempty"`
-	Metadata             map[string]interface{}                        `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata             Metadata                                      `url:"metadata,omitempty" json:"metadata,omitempty"`
 	PrefilledBankAccount *RedirectFlowCreateParamsPrefilledBankAccount `url:"prefilled_bank_account,omitempty" json:"prefilled_bank_account,omitempty"`
 	PrefilledCustomer    *RedirectFlowCreateParamsPrefilledCustomer    `url:"prefilled_customer,omitempty" json:"prefilled_customer,omitempty"`
 	Scheme               string                                        `url:"scheme,omitempty" json:"scheme,omitempty"`
@@ -94,6 +97,10 @@ type RedirectFlowCreateParams struct {
 // Creates a redirect flow object which can then be used to redirect your
 // customer to the GoCardless hosted payment pages.
 func (s *RedirectFlowServiceImpl) Create(ctx context.Context, p RedirectFlowCreateParams, opts ...RequestOption) (*RedirectFlow, error) {
+	if err := validateParams("redirect_flows", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/redirect_flows"))
 	if err != nil {
 		return nil, err
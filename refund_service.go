@@ -24,28 +24,32 @@ type RefundServiceImpl struct {
 }
 
 type RefundFx struct {
-	EstimatedExchangeRate string `url:"estimated_exchange_rate,omitempty" json:"estimated_exchange_rate,omitempty"`
-	ExchangeRate          string `url:"exchange_rate,omitempty" json:"exchange_rate,omitempty"`
-	FxAmount              int    `url:"fx_amount,omitempty" json:"fx_amount,omitempty"`
-	FxCurrency            string `url:"fx_currency,omitempty" json:"fx_currency,omitempty"`
+	EstimatedExchangeRate string                     `url:"estimated_exchange_rate,omitempty" json:"estimated_exchange_rate,omitempty"`
+	ExchangeRate          string                     `url:"exchange_rate,omitempty" json:"exchange_rate,omitempty"`
+	FxAmount              int                        `url:"fx_amount,omitempty" json:"fx_amount,omitempty"`
+	FxCurrency            string                     `url:"fx_currency,omitempty" json:"fx_currency,omitempty"`
+	Extra                 map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type RefundLinks struct {
-	Mandate string `url:"mandate,omitempty" json:"mandate,omitempty"`
-	Payment string `url:"payment,omitempty" json:"payment,omitempty"`
+	Mandate string                     `url:"mandate,omitempty" json:"mandate,omitempty"`
+	Payment string                     `url:"payment,omitempty" json:"payment,omitempty"`
+	Extra   map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 // Refund model
 type Refund struct {
-	Amount    int                    `url:"amount,omitempty" json:"amount,omitempty"`
-	CreatedAt string                 `url:"created_at,omitempty" json:"created_at,omitempty"`
-	Currency  string                 `url:"currency,omitempty" json:"currency,omitempty"`
-	Fx        *RefundFx              `url:"fx,omitempty" json:"fx,omitempty"`
-	Id        string                 `url:"id,omitempty" json:"id,omitempty"`
-	Links     *RefundLinks           `url:"links,omitempty" json:"links,omitempty"`
-	Metadata  map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	Reference string                 `url:"reference,omitempty" json:"reference,omitempty"`
-	Status    string                 `url:"status,omitempty" json:"status,omitempty"`
+	Amount    int                        `url:"amount,omitempty" json:"amount,omitempty"`
+	CreatedAt *Timestamp                 `url:"created_at,omitempty" json:"created_at,omitempty"`
+	Currency  string                     `url:"currency,omitempty" json:"currency,omitempty"`
+	Fx        *RefundFx                  `url:"fx,omitempty" json:"fx,omitempty"`
+	Id        string                     `url:"id,omitempty" json:"id,omitempty"`
+	Links     *RefundLinks               `url:"links,omitempty" json:"links,omitempty"`
+	Metadata  Metadata                   `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Reference string                     `url:"reference,omitempty" json:"reference,omitempty"`
+	Status    RefundStatus               `url:"status,omitempty" json:"status,omitempty"`
+	Extra     map[string]json.RawMessage `url:"-" json:"-"`
+	raw       json.RawMessage
 }
 
 type RefundService interface {
@@ -65,7 +69,7 @@ type RefundCreateParamsLinks struct {
 type RefundCreateParams struct {
 	Amount                  int                     `url:"amount,omitempty" json:"amount,omitempty"`
 	Links                   RefundCreateParamsLinks `url:"links,omitempty" json:"links,omitempty"`
-	Metadata                map[string]interface{}  `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata                Metadata                `url:"metadata,omitempty" json:"metadata,omitempty"`
 	Reference               string                  `url:"reference,omitempty" json:"reference,omitempty"`
 	TotalAmountConfirmation int                     `url:"total_amount_confirmation,omitempty" json:"total_amount_confirmation,omitempty"`
 }
@@ -89,6 +93,10 @@ type RefundCreateParams struct {
 // refund.
 //
 func (s *RefundServiceImpl) Create(ctx context.Context, p RefundCreateParams, opts ...RequestOption) (*Refund, error) {
+	if err := validateParams("refunds", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/refunds"))
 	if err != nil {
 		return nil, err
@@ -179,12 +187,7 @@ func (s *RefundServiceImpl) Create(ctx context.Context, p RefundCreateParams, op
 	return result.Refund, nil
 }
 
-type RefundListParamsCreatedAt struct {
-	Gt  string `url:"gt,omitempty" json:"gt,omitempty"`
-	Gte string `url:"gte,omitempty" json:"gte,omitempty"`
-	Lt  string `url:"lt,omitempty" json:"lt,omitempty"`
-	Lte string `url:"lte,omitempty" json:"lte,omitempty"`
-}
+type RefundListParamsCreatedAt = TimestampRange
 
 // RefundListParams parameters
 type RefundListParams struct {
@@ -501,12 +504,16 @@ func (s *RefundServiceImpl) Get(ctx context.Context, identity string, opts ...Re
 
 // RefundUpdateParams parameters
 type RefundUpdateParams struct {
-	Metadata map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 // Update
 // Updates a refund object.
 func (s *RefundServiceImpl) Update(ctx context.Context, identity string, p RefundUpdateParams, opts ...RequestOption) (*Refund, error) {
+	if err := validateParams("refunds", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/refunds/%v",
 		identity))
 	if err != nil {
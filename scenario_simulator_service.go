@@ -25,7 +25,9 @@ type ScenarioSimulatorServiceImpl struct {
 
 // ScenarioSimulator model
 type ScenarioSimulator struct {
-	Id string `url:"id,omitempty" json:"id,omitempty"`
+	Id    string                     `url:"id,omitempty" json:"id,omitempty"`
+	Extra map[string]json.RawMessage `url:"-" json:"-"`
+	raw   json.RawMessage
 }
 
 type ScenarioSimulatorService interface {
@@ -24,12 +24,14 @@ type SubscriptionServiceImpl struct {
 }
 
 type SubscriptionLinks struct {
-	Mandate string `url:"mandate,omitempty" json:"mandate,omitempty"`
+	Mandate string                     `url:"mandate,omitempty" json:"mandate,omitempty"`
+	Extra   map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 type SubscriptionUpcomingPayments struct {
-	Amount     int    `url:"amount,omitempty" json:"amount,omitempty"`
-	ChargeDate string `url:"charge_date,omitempty" json:"charge_date,omitempty"`
+	Amount     int                        `url:"amount,omitempty" json:"amount,omitempty"`
+	ChargeDate *Date                      `url:"charge_date,omitempty" json:"charge_date,omitempty"`
+	Extra      map[string]json.RawMessage `url:"-" json:"-"`
 }
 
 // Subscription model
@@ -37,23 +39,25 @@ type Subscription struct {
 	Amount                        int                            `url:"amount,omitempty" json:"amount,omitempty"`
 	AppFee                        int                            `url:"app_fee,omitempty" json:"app_fee,omitempty"`
 	Count                         int                            `url:"count,omitempty" json:"count,omitempty"`
-	CreatedAt                     string                         `url:"created_at,omitempty" json:"created_at,omitempty"`
+	CreatedAt                     *Timestamp                     `url:"created_at,omitempty" json:"created_at,omitempty"`
 	Currency                      string                         `url:"currency,omitempty" json:"currency,omitempty"`
 	DayOfMonth                    int                            `url:"day_of_month,omitempty" json:"day_of_month,omitempty"`
-	EarliestChargeDateAfterResume string                         `url:"earliest_charge_date_after_resume,omitempty" json:"earliest_charge_date_after_resume,omitempty"`
-	EndDate                       string                         `url:"end_date,omitempty" json:"end_date,omitempty"`
+	EarliestChargeDateAfterResume *Date                          `url:"earliest_charge_date_after_resume,omitempty" json:"earliest_charge_date_after_resume,omitempty"`
+	EndDate                       *Date                          `url:"end_date,omitempty" json:"end_date,omitempty"`
 	Id                            string                         `url:"id,omitempty" json:"id,omitempty"`
 	Interval                      int                            `url:"interval,omitempty" json:"interval,omitempty"`
-	IntervalUnit                  string                         `url:"interval_unit,omitempty" json:"interval_unit,omitempty"`
+	IntervalUnit                  IntervalUnit                   `url:"interval_unit,omitempty" json:"interval_unit,omitempty"`
 	Links                         *SubscriptionLinks             `url:"links,omitempty" json:"links,omitempty"`
-	Metadata                      map[string]interface{}         `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata                      Metadata                       `url:"metadata,omitempty" json:"metadata,omitempty"`
 	Month                         string                         `url:"month,omitempty" json:"month,omitempty"`
 	Name                          string                         `url:"name,omitempty" json:"name,omitempty"`
 	PaymentReference              string                         `url:"payment_reference,omitempty" json:"payment_reference,omitempty"`
 	RetryIfPossible               bool                           `url:"retry_if_possible,omitempty" json:"retry_if_possible,omitempty"`
-	StartDate                     string                         `url:"start_date,omitempty" json:"start_date,omitempty"`
-	Status                        string                         `url:"status,omitempty" json:"status,omitempty"`
+	StartDate                     *Date                          `url:"start_date,omitempty" json:"start_date,omitempty"`
+	Status                        SubscriptionStatus             `url:"status,omitempty" json:"status,omitempty"`
 	UpcomingPayments              []SubscriptionUpcomingPayments `url:"upcoming_payments,omitempty" json:"upcoming_payments,omitempty"`
+	Extra                         map[string]json.RawMessage     `url:"-" json:"-"`
+	raw                           json.RawMessage
 }
 
 type SubscriptionService interface {
@@ -78,21 +82,25 @@ type SubscriptionCreateParams struct {
 	Count            int                           `url:"count,omitempty" json:"count,omitempty"`
 	Currency         string                        `url:"currency,omitempty" json:"currency,omitempty"`
 	DayOfMonth       int                           `url:"day_of_month,omitempty" json:"day_of_month,omitempty"`
-	EndDate          string                        `url:"end_date,omitempty" json:"end_date,omitempty"`
+	EndDate          *Date                         `url:"end_date,omitempty" json:"end_date,omitempty"`
 	Interval         int                           `url:"interval,omitempty" json:"interval,omitempty"`
-	IntervalUnit     string                        `url:"interval_unit,omitempty" json:"interval_unit,omitempty"`
+	IntervalUnit     IntervalUnit                  `url:"interval_unit,omitempty" json:"interval_unit,omitempty"`
 	Links            SubscriptionCreateParamsLinks `url:"links,omitempty" json:"links,omitempty"`
-	Metadata         map[string]interface{}        `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata         Metadata                      `url:"metadata,omitempty" json:"metadata,omitempty"`
 	Month            string                        `url:"month,omitempty" json:"month,omitempty"`
 	Name             string                        `url:"name,omitempty" json:"name,omitempty"`
 	PaymentReference string                        `url:"payment_reference,omitempty" json:"payment_reference,omitempty"`
 	RetryIfPossible  bool                          `url:"retry_if_possible,omitempty" json:"retry_if_possible,omitempty"`
-	StartDate        string                        `url:"start_date,omitempty" json:"start_date,omitempty"`
+	StartDate        *Date                         `url:"start_date,omitempty" json:"start_date,omitempty"`
 }
 
 // Create
 // Creates a new subscription object
 func (s *SubscriptionServiceImpl) Create(ctx context.Context, p SubscriptionCreateParams, opts ...RequestOption) (*Subscription, error) {
+	if err := validateParams("subscriptions", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint() + "/subscriptions"))
 	if err != nil {
 		return nil, err
@@ -183,12 +191,7 @@ func (s *SubscriptionServiceImpl) Create(ctx context.Context, p SubscriptionCrea
 	return result.Subscription, nil
 }
 
-type SubscriptionListParamsCreatedAt struct {
-	Gt  string `url:"gt,omitempty" json:"gt,omitempty"`
-	Gte string `url:"gte,omitempty" json:"gte,omitempty"`
-	Lt  string `url:"lt,omitempty" json:"lt,omitempty"`
-	Lte string `url:"lte,omitempty" json:"lte,omitempty"`
-}
+type SubscriptionListParamsCreatedAt = TimestampRange
 
 // SubscriptionListParams parameters
 type SubscriptionListParams struct {
@@ -198,7 +201,7 @@ type SubscriptionListParams struct {
 	Customer  string                           `url:"customer,omitempty" json:"customer,omitempty"`
 	Limit     int                              `url:"limit,omitempty" json:"limit,omitempty"`
 	Mandate   string                           `url:"mandate,omitempty" json:"mandate,omitempty"`
-	Status    []string                         `url:"status,omitempty" json:"status,omitempty"`
+	Status    []SubscriptionStatus             `url:"status,omitempty" json:"status,omitempty"`
 }
 
 type SubscriptionListResultMetaCursors struct {
@@ -506,12 +509,12 @@ func (s *SubscriptionServiceImpl) Get(ctx context.Context, identity string, opts
 
 // SubscriptionUpdateParams parameters
 type SubscriptionUpdateParams struct {
-	Amount           int                    `url:"amount,omitempty" json:"amount,omitempty"`
-	AppFee           int                    `url:"app_fee,omitempty" json:"app_fee,omitempty"`
-	Metadata         map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	Name             string                 `url:"name,omitempty" json:"name,omitempty"`
-	PaymentReference string                 `url:"payment_reference,omitempty" json:"payment_reference,omitempty"`
-	RetryIfPossible  bool                   `url:"retry_if_possible,omitempty" json:"retry_if_possible,omitempty"`
+	Amount           *OptionalInt      `url:"amount,omitempty" json:"amount,omitempty"`
+	AppFee           *OptionalInt      `url:"app_fee,omitempty" json:"app_fee,omitempty"`
+	Metadata         *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Name             *OptionalString   `url:"name,omitempty" json:"name,omitempty"`
+	PaymentReference *OptionalString   `url:"payment_reference,omitempty" json:"payment_reference,omitempty"`
+	RetryIfPossible  *OptionalBool     `url:"retry_if_possible,omitempty" json:"retry_if_possible,omitempty"`
 }
 
 // Update
@@ -541,6 +544,10 @@ type SubscriptionUpdateParams struct {
 // as
 //
 func (s *SubscriptionServiceImpl) Update(ctx context.Context, identity string, p SubscriptionUpdateParams, opts ...RequestOption) (*Subscription, error) {
+	if err := validateParams("subscriptions", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/subscriptions/%v",
 		identity))
 	if err != nil {
@@ -634,8 +641,8 @@ func (s *SubscriptionServiceImpl) Update(ctx context.Context, identity string, p
 
 // SubscriptionPauseParams parameters
 type SubscriptionPauseParams struct {
-	Metadata    map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
-	PauseCycles int                    `url:"pause_cycles,omitempty" json:"pause_cycles,omitempty"`
+	Metadata    *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
+	PauseCycles *OptionalInt      `url:"pause_cycles,omitempty" json:"pause_cycles,omitempty"`
 }
 
 // Pause
@@ -681,6 +688,10 @@ type SubscriptionPauseParams struct {
 // `pause_cycles` cannot be satisfied.
 //
 func (s *SubscriptionServiceImpl) Pause(ctx context.Context, identity string, p SubscriptionPauseParams, opts ...RequestOption) (*Subscription, error) {
+	if err := validateParams("data", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/subscriptions/%v/actions/pause",
 		identity))
 	if err != nil {
@@ -774,7 +785,7 @@ func (s *SubscriptionServiceImpl) Pause(ctx context.Context, identity string, p
 
 // SubscriptionResumeParams parameters
 type SubscriptionResumeParams struct {
-	Metadata map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 // Resume
@@ -796,6 +807,10 @@ type SubscriptionResumeParams struct {
 // - `subscription_not_paused` if the subscription is not paused.
 //
 func (s *SubscriptionServiceImpl) Resume(ctx context.Context, identity string, p SubscriptionResumeParams, opts ...RequestOption) (*Subscription, error) {
+	if err := validateParams("data", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/subscriptions/%v/actions/resume",
 		identity))
 	if err != nil {
@@ -889,7 +904,7 @@ func (s *SubscriptionServiceImpl) Resume(ctx context.Context, identity string, p
 
 // SubscriptionCancelParams parameters
 type SubscriptionCancelParams struct {
-	Metadata map[string]interface{} `url:"metadata,omitempty" json:"metadata,omitempty"`
+	Metadata *OptionalMetadata `url:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
 // Cancel
@@ -900,6 +915,10 @@ type SubscriptionCancelParams struct {
 // This will fail with a cancellation_failed error if the subscription is
 // already cancelled or finished.
 func (s *SubscriptionServiceImpl) Cancel(ctx context.Context, identity string, p SubscriptionCancelParams, opts ...RequestOption) (*Subscription, error) {
+	if err := validateParams("data", p); err != nil {
+		return nil, err
+	}
+
 	uri, err := url.Parse(fmt.Sprintf(s.config.Endpoint()+"/subscriptions/%v/actions/cancel",
 		identity))
 	if err != nil {
@@ -25,12 +25,14 @@ type TaxRateServiceImpl struct {
 
 // TaxRate model
 type TaxRate struct {
-	EndDate      string `url:"end_date,omitempty" json:"end_date,omitempty"`
-	Id           string `url:"id,omitempty" json:"id,omitempty"`
-	Jurisdiction string `url:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
-	Percentage   string `url:"percentage,omitempty" json:"percentage,omitempty"`
-	StartDate    string `url:"start_date,omitempty" json:"start_date,omitempty"`
-	Type         string `url:"type,omitempty" json:"type,omitempty"`
+	EndDate      *Date                      `url:"end_date,omitempty" json:"end_date,omitempty"`
+	Id           string                     `url:"id,omitempty" json:"id,omitempty"`
+	Jurisdiction string                     `url:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	Percentage   string                     `url:"percentage,omitempty" json:"percentage,omitempty"`
+	StartDate    *Date                      `url:"start_date,omitempty" json:"start_date,omitempty"`
+	Type         string                     `url:"type,omitempty" json:"type,omitempty"`
+	Extra        map[string]json.RawMessage `url:"-" json:"-"`
+	raw          json.RawMessage
 }
 
 type TaxRateService interface {
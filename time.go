@@ -0,0 +1,213 @@
+package gocardless
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// timestampLayout is the layout of the timestamps sent by the API.
+const timestampLayout = "2006-01-02T15:04:05.000Z07:00"
+
+// Timestamp is an instant sent to or received from the API, such as a
+// creation time. Timestamps received from the API are encoded back exactly
+// as they were received.
+type Timestamp struct {
+	time.Time
+
+	// layout is the layout the timestamp was decoded from, if any.
+	layout string
+}
+
+// NewTimestamp returns a Timestamp holding t, for use in params.
+func NewTimestamp(t time.Time) *Timestamp {
+	return &Timestamp{Time: t}
+}
+
+// ParseTimestamp parses an RFC 3339 timestamp.
+func ParseTimestamp(s string) (Timestamp, error) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return Timestamp{}, err
+	}
+
+	layout := "2006-01-02T15:04:05"
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		n := strings.IndexAny(s[i:], "Zz+-") - 1
+		layout += "." + strings.Repeat("0", n)
+	}
+	layout += "Z07:00"
+	return Timestamp{Time: t, layout: layout}, nil
+}
+
+// String returns the timestamp as sent to the API.
+func (t Timestamp) String() string {
+	if t.layout != "" {
+		return t.Time.Format(t.layout)
+	}
+	if t.Nanosecond()%int(time.Millisecond) != 0 {
+		return t.Time.Format(time.RFC3339Nano)
+	}
+	return t.Time.Format(timestampLayout)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t Timestamp) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *Timestamp) UnmarshalText(data []byte) error {
+	v, err := ParseTimestamp(string(data))
+	if err != nil {
+		return err
+	}
+	*t = v
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + t.String() + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return errors.New("timestamp must be a JSON string")
+	}
+	return t.UnmarshalText(data[1 : len(data)-1])
+}
+
+// EncodeValues implements query.Encoder.
+func (t Timestamp) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, t.String())
+	return nil
+}
+
+// Date is a calendar day, such as a charge date, without a time zone.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewDate returns the Date for the given day, for use in params.
+func NewDate(year int, month time.Month, day int) *Date {
+	d := DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+	return &d
+}
+
+// DateOf returns the Date on which t falls, in the location of t.
+func DateOf(t time.Time) Date {
+	var d Date
+	d.Year, d.Month, d.Day = t.Date()
+	return d
+}
+
+// ParseDate parses a date in the YYYY-MM-DD format.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+// String returns the date in the YYYY-MM-DD format.
+func (d Date) String() string {
+	return d.In(time.UTC).Format("2006-01-02")
+}
+
+// IsZero reports whether d is the zero Date.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// In returns the time at which the date starts in loc.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// AddDays returns the date n days after d.
+func (d Date) AddDays(n int) Date {
+	return DateOf(d.In(time.UTC).AddDate(0, 0, n))
+}
+
+// Before reports whether d is before u.
+func (d Date) Before(u Date) bool {
+	return d.In(time.UTC).Before(u.In(time.UTC))
+}
+
+// After reports whether d is after u.
+func (d Date) After(u Date) bool {
+	return u.Before(d)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Date) UnmarshalText(data []byte) error {
+	v, err := ParseDate(string(data))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// EncodeValues implements query.Encoder.
+func (d Date) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, d.String())
+	return nil
+}
+
+// TimestampRange filters list results by a timestamp, such as their creation
+// time. Unset bounds are ignored.
+type TimestampRange struct {
+	Gt  *Timestamp `url:"gt,omitempty" json:"gt,omitempty"`
+	Gte *Timestamp `url:"gte,omitempty" json:"gte,omitempty"`
+	Lt  *Timestamp `url:"lt,omitempty" json:"lt,omitempty"`
+	Lte *Timestamp `url:"lte,omitempty" json:"lte,omitempty"`
+}
+
+// CreatedAfter returns a filter on resources created strictly after t.
+func CreatedAfter(t time.Time) *TimestampRange {
+	return &TimestampRange{Gt: NewTimestamp(t)}
+}
+
+// CreatedSince returns a filter on resources created at or after t.
+func CreatedSince(t time.Time) *TimestampRange {
+	return &TimestampRange{Gte: NewTimestamp(t)}
+}
+
+// CreatedBefore returns a filter on resources created strictly before t.
+func CreatedBefore(t time.Time) *TimestampRange {
+	return &TimestampRange{Lt: NewTimestamp(t)}
+}
+
+// CreatedBetween returns a filter on resources created within [from, to).
+func CreatedBetween(from, to time.Time) *TimestampRange {
+	return &TimestampRange{Gte: NewTimestamp(from), Lt: NewTimestamp(to)}
+}
+
+// DateRange filters list results by a date, such as their charge date. Unset
+// bounds are ignored.
+type DateRange struct {
+	Gt  *Date `url:"gt,omitempty" json:"gt,omitempty"`
+	Gte *Date `url:"gte,omitempty" json:"gte,omitempty"`
+	Lt  *Date `url:"lt,omitempty" json:"lt,omitempty"`
+	Lte *Date `url:"lte,omitempty" json:"lte,omitempty"`
+}
+
+// DatesBetween returns a filter on dates within [from, to].
+func DatesBetween(from, to Date) *DateRange {
+	return &DateRange{Gte: &from, Lte: &to}
+}
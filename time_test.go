@@ -0,0 +1,93 @@
+package gocardless
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestTimestampRoundTrips(t *testing.T) {
+	for _, s := range []string{
+		"2014-01-01T12:00:00.000Z",
+		"2014-01-01T12:00:00Z",
+		"2014-01-01T12:00:00.123456Z",
+		"2014-01-01T13:00:00.500+01:00",
+	} {
+		var ts Timestamp
+		if err := json.Unmarshal([]byte(`"`+s+`"`), &ts); err != nil {
+			t.Fatal(err)
+		}
+		data, err := json.Marshal(ts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != `"`+s+`"` {
+			t.Fatalf("Expected %q, got %s", s, data)
+		}
+	}
+}
+
+func TestTimestampDecodesToTime(t *testing.T) {
+	var p Payment
+	if err := json.Unmarshal([]byte(`{"created_at":"2014-01-01T12:00:00.000Z","charge_date":"2014-05-21"}`), &p); err != nil {
+		t.Fatal(err)
+	}
+	if !p.CreatedAt.Equal(time.Date(2014, 1, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Unexpected created_at %v", p.CreatedAt)
+	}
+	if *p.ChargeDate != (Date{2014, time.May, 21}) {
+		t.Fatalf("Unexpected charge_date %v", p.ChargeDate)
+	}
+}
+
+func TestNewTimestampUsesMilliseconds(t *testing.T) {
+	ts := NewTimestamp(time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC))
+	if ts.String() != "2023-01-02T03:04:05.000Z" {
+		t.Fatalf("Expected %q, got %q", "2023-01-02T03:04:05.000Z", ts.String())
+	}
+}
+
+func TestDate(t *testing.T) {
+	d, err := ParseDate("2024-02-28")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.AddDays(1).String() != "2024-02-29" {
+		t.Fatalf("Expected %q, got %q", "2024-02-29", d.AddDays(1))
+	}
+	if !d.Before(d.AddDays(1)) || d.After(d) {
+		t.Fatal("Unexpected date ordering")
+	}
+	if _, err := ParseDate("2024-02-30"); err == nil {
+		t.Fatal("Expected error for invalid date")
+	}
+	if DateOf(time.Date(2024, 3, 1, 23, 30, 0, 0, time.UTC)) != (Date{2024, time.March, 1}) {
+		t.Fatal("Unexpected date")
+	}
+}
+
+func TestDateAndTimestampParamsEncoding(t *testing.T) {
+	p := PaymentListParams{
+		CreatedAt:  CreatedAfter(time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)),
+		ChargeDate: DatesBetween(Date{2023, time.February, 1}, Date{2023, time.February, 28}),
+	}
+	v, err := query.Values(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "charge_date%5Bgte%5D=2023-02-01&charge_date%5Blte%5D=2023-02-28&created_at%5Bgt%5D=2023-01-02T03%3A04%3A05.000Z"
+	if v.Encode() != expected {
+		t.Fatalf("Expected %q, got %q", expected, v.Encode())
+	}
+
+	data, err := json.Marshal(PaymentCreateParams{ChargeDate: NewDate(2023, time.March, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = `{"charge_date":"2023-03-01","links":{}}`
+	if string(data) != expected {
+		t.Fatalf("Expected %s, got %s", expected, data)
+	}
+}
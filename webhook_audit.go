@@ -0,0 +1,215 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// WebhookEventIDs returns the IDs of the events held in the body of a
+// webhook, as recorded in its RequestBody.
+func WebhookEventIDs(w Webhook) ([]string, error) {
+	var body struct {
+		Events []struct {
+			Id string `json:"id"`
+		} `json:"events"`
+	}
+	if err := json.Unmarshal([]byte(w.RequestBody), &body); err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(body.Events))
+	for _, e := range body.Events {
+		ids = append(ids, e.Id)
+	}
+	return ids, nil
+}
+
+// WebhookAudit holds the failed webhook deliveries found in a time window.
+type WebhookAudit struct {
+	// Failed holds the failed deliveries, as listed by the API.
+	Failed []Webhook
+	// ByResponseCode groups the failed deliveries by the status code of the
+	// response to them, 0 meaning no response was received.
+	ByResponseCode map[int][]Webhook
+}
+
+// ResponseCodes returns the response codes of the failed deliveries, in
+// ascending order.
+func (a *WebhookAudit) ResponseCodes() []int {
+	codes := make([]int, 0, len(a.ByResponseCode))
+	for code := range a.ByResponseCode {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	return codes
+}
+
+// RedeliveryResult is the outcome of redelivering one webhook.
+type RedeliveryResult struct {
+	// Webhook is the failed delivery.
+	Webhook Webhook
+	// EventIDs are the IDs of the events in the redelivered body.
+	EventIDs []string
+	// Retry is the new delivery, unset on dry runs or failures.
+	Retry *Webhook
+	// Err is the error returned when retrying the webhook, if any.
+	Err error
+}
+
+// RedeliveryReport holds the results of redelivering webhooks.
+type RedeliveryReport struct {
+	DryRun  bool
+	Results []RedeliveryResult
+}
+
+// Failed returns the results of the webhooks which couldn't be retried.
+func (r *RedeliveryReport) Failed() []RedeliveryResult {
+	var failed []RedeliveryResult
+	for _, res := range r.Results {
+		if res.Err != nil {
+			failed = append(failed, res)
+		}
+	}
+	return failed
+}
+
+// WriteSummary writes one line per webhook, listing its response code, the
+// events it held and the outcome of its redelivery.
+func (r *RedeliveryReport) WriteSummary(w io.Writer) error {
+	for _, res := range r.Results {
+		var outcome string
+		switch {
+		case res.Err != nil:
+			outcome = "failed: " + res.Err.Error()
+		case r.DryRun:
+			outcome = "would retry"
+		case res.Retry != nil:
+			outcome = "retried as " + res.Retry.Id
+		default:
+			outcome = "retried"
+		}
+		_, err := fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", res.Webhook.Id, res.Webhook.ResponseCode, strings.Join(res.EventIDs, ","), outcome)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RedelivererOption is used to configure a WebhookRedeliverer
+type RedelivererOption func(*WebhookRedeliverer) error
+
+// WithRedeliveryInterval sets the minimum delay between two retries. It
+// defaults to one second.
+func WithRedeliveryInterval(d time.Duration) RedelivererOption {
+	return func(r *WebhookRedeliverer) error {
+		if d < 0 {
+			return errors.New("redelivery interval must not be negative")
+		}
+		r.interval = d
+		return nil
+	}
+}
+
+// WithDryRun makes the WebhookRedeliverer report what it would retry,
+// without retrying anything.
+func WithDryRun() RedelivererOption {
+	return func(r *WebhookRedeliverer) error {
+		r.dryRun = true
+		return nil
+	}
+}
+
+// WebhookRedeliverer finds failed webhook deliveries and retries them in
+// bulk.
+type WebhookRedeliverer struct {
+	service  WebhookService
+	interval time.Duration
+	dryRun   bool
+}
+
+// NewWebhookRedeliverer returns a WebhookRedeliverer using s.
+func NewWebhookRedeliverer(s WebhookService, opts ...RedelivererOption) (*WebhookRedeliverer, error) {
+	if s == nil {
+		return nil, errors.New("missing webhook service")
+	}
+	r := &WebhookRedeliverer{
+		service:  s,
+		interval: time.Second,
+	}
+	for _, opt := range opts {
+		if err := opt(r); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// FindFailed lists the webhooks created within [from, to) which weren't
+// delivered successfully. Test webhooks are included.
+func (r *WebhookRedeliverer) FindFailed(ctx context.Context, from, to time.Time) (*WebhookAudit, error) {
+	// Successful=false can't be sent to the API, so every webhook of the
+	// window is listed and filtered here.
+	p := WebhookListParams{
+		CreatedAt: CreatedBetween(from.UTC(), to.UTC()),
+	}
+
+	audit := &WebhookAudit{
+		ByResponseCode: make(map[int][]Webhook),
+	}
+	for {
+		res, err := r.service.List(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range res.Webhooks {
+			if w.Successful {
+				continue
+			}
+			audit.Failed = append(audit.Failed, w)
+			audit.ByResponseCode[w.ResponseCode] = append(audit.ByResponseCode[w.ResponseCode], w)
+		}
+
+		if res.Meta.Cursors == nil || res.Meta.Cursors.After == "" {
+			break
+		}
+		p.After = res.Meta.Cursors.After
+	}
+	return audit, nil
+}
+
+// Redeliver retries the webhooks one at a time, waiting for the configured
+// interval between retries. Failing retries are recorded in the report
+// rather than stopping the redelivery; an error is only returned if ctx is
+// done before all webhooks are handled, along with the partial report.
+func (r *WebhookRedeliverer) Redeliver(ctx context.Context, webhooks []Webhook) (*RedeliveryReport, error) {
+	report := &RedeliveryReport{DryRun: r.dryRun}
+
+	var last time.Time
+	for _, w := range webhooks {
+		res := RedeliveryResult{Webhook: w}
+		res.EventIDs, _ = WebhookEventIDs(w)
+
+		if !r.dryRun {
+			if wait := r.interval - time.Since(last); !last.IsZero() && wait > 0 {
+				timer := time.NewTimer(wait)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return report, ctx.Err()
+				case <-timer.C:
+				}
+			}
+			last = time.Now()
+			res.Retry, res.Err = r.service.Retry(ctx, w.Id)
+		}
+
+		report.Results = append(report.Results, res)
+	}
+	return report, nil
+}
@@ -0,0 +1,132 @@
+package gocardless
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeWebhookService struct {
+	WebhookService
+	pages   [][]Webhook
+	params  []WebhookListParams
+	retried []string
+}
+
+func (s *fakeWebhookService) List(ctx context.Context, p WebhookListParams, opts ...RequestOption) (*WebhookListResult, error) {
+	s.params = append(s.params, p)
+	page := len(s.params) - 1
+	res := &WebhookListResult{Webhooks: s.pages[page]}
+	if page < len(s.pages)-1 {
+		res.Meta.Cursors = &WebhookListResultMetaCursors{After: "cursor"}
+	}
+	return res, nil
+}
+
+func (s *fakeWebhookService) Retry(ctx context.Context, identity string, opts ...RequestOption) (*Webhook, error) {
+	s.retried = append(s.retried, identity)
+	if identity == "WB3" {
+		return nil, errors.New("not found")
+	}
+	return &Webhook{Id: "RETRY_" + identity}, nil
+}
+
+func auditWebhooks() *fakeWebhookService {
+	body := func(ids ...string) string {
+		var events []string
+		for _, id := range ids {
+			events = append(events, `{"id":"`+id+`"}`)
+		}
+		return `{"events":[` + strings.Join(events, ",") + `]}`
+	}
+	return &fakeWebhookService{pages: [][]Webhook{
+		{
+			{Id: "WB1", ResponseCode: 500, RequestBody: body("EV1", "EV2")},
+			{Id: "WB2", ResponseCode: 200, Successful: true, RequestBody: body("EV3")},
+		},
+		{
+			{Id: "WB3", ResponseCode: 0, RequestBody: body("EV4")},
+			{Id: "WB4", ResponseCode: 500, RequestBody: body("EV5")},
+		},
+	}}
+}
+
+func TestWebhookRedelivererFindsFailedWebhooks(t *testing.T) {
+	svc := auditWebhooks()
+	r, err := NewWebhookRedeliverer(svc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	audit, err := r.FindFailed(context.TODO(), from, from.Add(24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(audit.Failed) != 3 {
+		t.Fatalf("Expected 3 failed webhooks, got %d", len(audit.Failed))
+	}
+	codes := audit.ResponseCodes()
+	if len(codes) != 2 || codes[0] != 0 || codes[1] != 500 {
+		t.Fatalf("Expected [0 500], got %v", codes)
+	}
+	if len(audit.ByResponseCode[500]) != 2 {
+		t.Fatalf("Expected 2 webhooks with code 500, got %d", len(audit.ByResponseCode[500]))
+	}
+	if svc.params[0].CreatedAt.Gte.String() != "2023-01-01T00:00:00.000Z" || svc.params[0].CreatedAt.Lt.String() != "2023-01-02T00:00:00.000Z" {
+		t.Fatalf("Unexpected created_at filter %+v", svc.params[0].CreatedAt)
+	}
+	if svc.params[1].After != "cursor" {
+		t.Fatalf("Expected second page to be requested")
+	}
+}
+
+func TestWebhookRedelivererRetriesWebhooks(t *testing.T) {
+	svc := auditWebhooks()
+	failed := []Webhook{svc.pages[0][0], svc.pages[1][0], svc.pages[1][1]}
+
+	dry, err := NewWebhookRedeliverer(svc, WithDryRun())
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := dry.Redeliver(context.TODO(), failed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(svc.retried) != 0 {
+		t.Fatalf("Expected no retries on dry run, got %v", svc.retried)
+	}
+	if len(report.Results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(report.Results))
+	}
+
+	r, err := NewWebhookRedeliverer(svc, WithRedeliveryInterval(time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err = r.Redeliver(context.TODO(), failed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(svc.retried) != 3 {
+		t.Fatalf("Expected 3 retries, got %v", svc.retried)
+	}
+	if len(report.Failed()) != 1 {
+		t.Fatalf("Expected 1 failed retry, got %d", len(report.Failed()))
+	}
+
+	var summary bytes.Buffer
+	if err := report.WriteSummary(&summary); err != nil {
+		t.Fatal(err)
+	}
+	expected := "WB1\t500\tEV1,EV2\tretried as RETRY_WB1\n" +
+		"WB3\t0\tEV4\tfailed: not found\n" +
+		"WB4\t500\tEV5\tretried as RETRY_WB4\n"
+	if summary.String() != expected {
+		t.Fatalf("Expected %q, got %q", expected, summary.String())
+	}
+}
@@ -0,0 +1,200 @@
+package gocardless
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// BatchPolicy controls how a WebhookHandler deals with events failing
+// within a webhook batch.
+type BatchPolicy int
+
+const (
+	// StopOnFirstError stops processing the batch at the first failing event
+	// and responds with an error, so that GoCardless delivers the whole batch
+	// again. This is the default.
+	StopOnFirstError BatchPolicy = iota
+
+	// ProcessAllThenFail processes every event of the batch, then responds
+	// with an error if any of them failed.
+	ProcessAllThenFail
+
+	// AcceptFailures processes every event of the batch and hands the
+	// failures to the batch report callback, which is responsible for
+	// retrying them. The webhook is acknowledged unless the callback fails.
+	AcceptFailures
+)
+
+// EventResult is the outcome of processing one event of a webhook batch.
+type EventResult struct {
+	Event Event
+	// Index is the position of the event within the batch.
+	Index int
+	// Err is the error returned by the event handler, if any.
+	Err error
+	// Skipped is set when the event wasn't handled because an earlier event
+	// failed.
+	Skipped bool
+	// Duplicate is set when the event store reported the event as already
+	// processed.
+	Duplicate bool
+	// Duration is the time spent processing the event.
+	Duration time.Duration
+}
+
+// BatchReport holds the results of processing the events of a webhook.
+type BatchReport struct {
+	Info    WebhookInfo
+	Results []EventResult
+}
+
+// Failed returns the results of the events whose handler failed.
+func (r *BatchReport) Failed() []EventResult {
+	var failed []EventResult
+	for _, res := range r.Results {
+		if res.Err != nil {
+			failed = append(failed, res)
+		}
+	}
+	return failed
+}
+
+// Err returns an error describing the failed events, or nil if none failed.
+func (r *BatchReport) Err() error {
+	failed := r.Failed()
+	switch len(failed) {
+	case 0:
+		return nil
+	case 1:
+		return failed[0].Err
+	default:
+		return fmt.Errorf("%d of %d events failed, first error: %w", len(failed), len(r.Results), failed[0].Err)
+	}
+}
+
+// WithBatchPolicy sets how failing events within a webhook batch are dealt
+// with. The AcceptFailures policy requires WithBatchReportCallback.
+func WithBatchPolicy(p BatchPolicy) WebhookOption {
+	return func(h *WebhookHandler) error {
+		switch p {
+		case StopOnFirstError, ProcessAllThenFail, AcceptFailures:
+			h.batchPolicy = p
+			return nil
+		default:
+			return errors.New("invalid batch policy")
+		}
+	}
+}
+
+// WithBatchReportCallback sets a function called with the results of every
+// webhook batch once all its events have been processed. An error returned
+// by the callback fails the webhook.
+func WithBatchReportCallback(fn func(context.Context, *BatchReport) error) WebhookOption {
+	return func(h *WebhookHandler) error {
+		h.onBatchReport = fn
+		return nil
+	}
+}
+
+// WithConcurrency sets the number of events of a batch processed
+// concurrently. Events are processed one at a time, in order, by default.
+// Handlers panicking while processed concurrently fail their event with a
+// *PanicError.
+func WithConcurrency(n int) WebhookOption {
+	return func(h *WebhookHandler) error {
+		if n < 1 {
+			return errors.New("concurrency must be positive")
+		}
+		h.concurrency = n
+		return nil
+	}
+}
+
+func (h *WebhookHandler) processBatch(ctx context.Context, batch webhookBody, info WebhookInfo) *BatchReport {
+	report := &BatchReport{
+		Info:    info,
+		Results: make([]EventResult, len(batch.Events)),
+	}
+	ctx = ContextWithEnrichCache(ctx, NewEnrichCache())
+
+	var stopped int32
+	process := func(i int) {
+		res := &report.Results[i]
+		res.Event = batch.Events[i]
+		res.Index = i
+		if atomic.LoadInt32(&stopped) != 0 {
+			res.Skipped = true
+			return
+		}
+
+		eventInfo := info
+		eventInfo.Index = i
+		start := time.Now()
+		res.Duplicate, res.Err = h.processEvent(ContextWithWebhookInfo(ctx, eventInfo), res.Event)
+		res.Duration = time.Since(start)
+
+		if res.Err != nil && h.batchPolicy == StopOnFirstError {
+			atomic.StoreInt32(&stopped, 1)
+		}
+	}
+
+	workers := h.concurrency
+	if workers <= 1 {
+		for i := range batch.Events {
+			process(i)
+		}
+		return report
+	}
+
+	// Panics in the workers can't be recovered by net/http, which would
+	// crash the process, so they fail the event instead.
+	recovered := func(i int) {
+		defer func() {
+			if v := recover(); v != nil {
+				report.Results[i].Err = &PanicError{Value: v, Stack: debug.Stack()}
+				if h.batchPolicy == StopOnFirstError {
+					atomic.StoreInt32(&stopped, 1)
+				}
+			}
+		}()
+		process(i)
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for n := 0; n < workers; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				recovered(i)
+			}
+		}()
+	}
+	for i := range batch.Events {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return report
+}
+
+// reportBatch hands the report to the callback and returns the error to
+// respond with, according to the batch policy.
+func (h *WebhookHandler) reportBatch(ctx context.Context, report *BatchReport) error {
+	if h.onBatchReport != nil {
+		if err := h.onBatchReport(ctx, report); err != nil {
+			return err
+		}
+	}
+	if h.batchPolicy == AcceptFailures {
+		return nil
+	}
+	return report.Err()
+}
@@ -0,0 +1,137 @@
+package gocardless
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var batchBody = []byte(`{"events":[{"id":"EV1"},{"id":"EV2"},{"id":"EV3"},{"id":"EV4"}],"meta":{"webhook_id":"WB1"}}`)
+
+func serveBatch(t *testing.T, h EventHandler, opts ...WebhookOption) (int, *BatchReport) {
+	var report *BatchReport
+	opts = append(opts, WithBatchReportCallback(func(ctx context.Context, r *BatchReport) error {
+		report = r
+		return nil
+	}))
+	wh, err := NewWebhookHandler("testing", h, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "/webhook", bytes.NewReader(batchBody))
+	r.Header.Set("Webhook-Signature", signWebhookBody("testing", batchBody))
+	wh.ServeHTTP(w, r)
+	return w.Code, report
+}
+
+func failSecondEvent(e Event) error {
+	if e.Id == "EV2" {
+		return errors.New("failed")
+	}
+	return nil
+}
+
+func TestWebhookBatchPolicies(t *testing.T) {
+	tests := []struct {
+		policy  BatchPolicy
+		code    int
+		skipped int
+	}{
+		{StopOnFirstError, http.StatusInternalServerError, 2},
+		{ProcessAllThenFail, http.StatusInternalServerError, 0},
+		{AcceptFailures, http.StatusNoContent, 0},
+	}
+
+	for _, tt := range tests {
+		code, report := serveBatch(t, EventHandlerFunc(failSecondEvent), WithBatchPolicy(tt.policy))
+		if code != tt.code {
+			t.Fatalf("policy %d: Expected %d, got %d", tt.policy, tt.code, code)
+		}
+		if report.Info.WebhookID != "WB1" {
+			t.Fatalf("policy %d: Expected %q, got %q", tt.policy, "WB1", report.Info.WebhookID)
+		}
+
+		skipped := 0
+		for i, res := range report.Results {
+			if res.Index != i {
+				t.Fatalf("policy %d: Expected index %d, got %d", tt.policy, i, res.Index)
+			}
+			if res.Skipped {
+				skipped++
+			}
+		}
+		if skipped != tt.skipped {
+			t.Fatalf("policy %d: Expected %d skipped events, got %d", tt.policy, tt.skipped, skipped)
+		}
+
+		failed := report.Failed()
+		if len(failed) != 1 || failed[0].Event.Id != "EV2" {
+			t.Fatalf("policy %d: Expected EV2 to fail, got %v", tt.policy, failed)
+		}
+	}
+}
+
+func TestWebhookAcceptFailuresRequiresCallback(t *testing.T) {
+	_, err := NewWebhookHandler("testing", EventHandlerFunc(failSecondEvent), WithBatchPolicy(AcceptFailures))
+	if err == nil {
+		t.Fatal("Expected error without batch report callback")
+	}
+}
+
+func TestWebhookProcessesEventsConcurrently(t *testing.T) {
+	var running, maxRunning int32
+	h := EventHandlerFunc(func(e Event) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		return nil
+	})
+
+	code, report := serveBatch(t, h, WithConcurrency(2))
+	if code != http.StatusNoContent {
+		t.Fatalf("Expected %d, got %d", http.StatusNoContent, code)
+	}
+	if maxRunning != 2 {
+		t.Fatalf("Expected 2 concurrent events, got %d", maxRunning)
+	}
+	for i, res := range report.Results {
+		if res.Event.Id == "" || res.Duration == 0 {
+			t.Fatalf("Expected result %d to be filled, got %+v", i, res)
+		}
+	}
+}
+
+func TestWebhookRecoversConcurrentPanics(t *testing.T) {
+	h := EventHandlerFunc(func(e Event) error {
+		if e.Id == "EV3" {
+			panic("boom")
+		}
+		return nil
+	})
+
+	code, report := serveBatch(t, h, WithConcurrency(2), WithBatchPolicy(ProcessAllThenFail))
+	if code != http.StatusInternalServerError {
+		t.Fatalf("Expected %d, got %d", http.StatusInternalServerError, code)
+	}
+	failed := report.Failed()
+	if len(failed) != 1 || failed[0].Event.Id != "EV3" {
+		t.Fatalf("Expected EV3 to fail, got %v", failed)
+	}
+	var perr *PanicError
+	if !errors.As(failed[0].Err, &perr) || perr.Value != "boom" {
+		t.Fatalf("Expected *PanicError, got %v", failed[0].Err)
+	}
+}
@@ -0,0 +1,136 @@
+package gocardless
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"time"
+)
+
+// Logger is the interface used by the event middlewares to log. It is
+// implemented by *log.Logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// EventMiddleware wraps an event handler to add behaviour around it.
+type EventMiddleware func(ContextEventHandler) ContextEventHandler
+
+// Chain wraps h with the middlewares, the first one being the outermost.
+// The result can be used with Worker and EventPoller as well.
+func Chain(h EventHandler, mws ...EventMiddleware) ContextEventHandlerFunc {
+	var ch ContextEventHandler
+	if c, ok := h.(ContextEventHandler); ok {
+		ch = c
+	} else {
+		ch = ContextEventHandlerFunc(func(ctx context.Context, e Event) error {
+			return h.HandleEvent(e)
+		})
+	}
+	for i := len(mws) - 1; i >= 0; i-- {
+		ch = mws[i](ch)
+	}
+	return ch.HandleEventContext
+}
+
+// WithMiddleware wraps the event handler of the WebhookHandler with the
+// middlewares, the first one being the outermost.
+func WithMiddleware(mws ...EventMiddleware) WebhookOption {
+	return func(h *WebhookHandler) error {
+		h.middlewares = append(h.middlewares, mws...)
+		return nil
+	}
+}
+
+// PanicError is returned by the Recover middleware when an event handler
+// panics. It is also the error of events whose handler panics while
+// processed concurrently, see WithConcurrency.
+type PanicError struct {
+	Value interface{}
+	Stack []byte
+}
+
+func (err *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", err.Value)
+}
+
+// Recover turns panics in event handlers into a *PanicError, logging the
+// panic and its stack to logger if it isn't nil. WebhookHandler then fails
+// the webhook as with any other error.
+func Recover(logger Logger) EventMiddleware {
+	return func(next ContextEventHandler) ContextEventHandler {
+		return ContextEventHandlerFunc(func(ctx context.Context, e Event) (err error) {
+			defer func() {
+				if v := recover(); v != nil {
+					perr := &PanicError{Value: v, Stack: debug.Stack()}
+					if logger != nil {
+						logger.Printf("panic handling event %s: %v\n%s", e.Id, v, perr.Stack)
+					}
+					err = perr
+				}
+			}()
+			return next.HandleEventContext(ctx, e)
+		})
+	}
+}
+
+// Event outcomes reported by the Observe and LogEvents middlewares.
+const (
+	OutcomeOK    = "ok"
+	OutcomeError = "error"
+	OutcomePanic = "panic"
+)
+
+// EventObservation describes the handling of one event.
+type EventObservation struct {
+	Event    Event
+	Duration time.Duration
+	Err      error
+	// Outcome is one of OutcomeOK, OutcomeError or OutcomePanic. Panics are
+	// only detected when Recover is an inner middleware.
+	Outcome string
+}
+
+// Observe calls fn after each event is handled, e.g. to update metrics
+// counters and timings.
+func Observe(fn func(context.Context, EventObservation)) EventMiddleware {
+	return func(next ContextEventHandler) ContextEventHandler {
+		return ContextEventHandlerFunc(func(ctx context.Context, e Event) error {
+			start := time.Now()
+			err := next.HandleEventContext(ctx, e)
+
+			o := EventObservation{
+				Event:    e,
+				Duration: time.Since(start),
+				Err:      err,
+				Outcome:  OutcomeOK,
+			}
+			var perr *PanicError
+			switch {
+			case errors.As(err, &perr):
+				o.Outcome = OutcomePanic
+			case err != nil:
+				o.Outcome = OutcomeError
+			}
+			fn(ctx, o)
+			return err
+		})
+	}
+}
+
+// LogEvents logs one line per handled event, holding its ID, resource type,
+// action, outcome and duration, plus the error if any.
+func LogEvents(logger Logger) EventMiddleware {
+	return Observe(func(ctx context.Context, o EventObservation) {
+		line := fmt.Sprintf("event_id=%s resource_type=%s action=%s outcome=%s duration=%s",
+			o.Event.Id, o.Event.ResourceType, o.Event.Action, o.Outcome, o.Duration)
+		if info, ok := WebhookInfoFromContext(ctx); ok && info.WebhookID != "" {
+			line += " webhook_id=" + info.WebhookID
+		}
+		if o.Err != nil {
+			line += fmt.Sprintf(" error=%q", o.Err.Error())
+		}
+		logger.Printf("%s", line)
+	})
+}
@@ -0,0 +1,106 @@
+package gocardless
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type testLogger struct {
+	lines []string
+}
+
+func (l *testLogger) Printf(format string, v ...interface{}) {
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+func TestWebhookRecoversFromPanics(t *testing.T) {
+	logger := &testLogger{}
+	var observed []EventObservation
+
+	wh, err := NewWebhookHandler("testing", EventHandlerFunc(func(e Event) error {
+		switch e.Id {
+		case "EV2":
+			return errors.New("failed")
+		case "EV3":
+			panic("boom")
+		}
+		return nil
+	}),
+		WithBatchPolicy(ProcessAllThenFail),
+		WithMiddleware(
+			Observe(func(ctx context.Context, o EventObservation) {
+				observed = append(observed, o)
+			}),
+			LogEvents(logger),
+			Recover(logger),
+		),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "/webhook", bytes.NewReader(batchBody))
+	r.Header.Set("Webhook-Signature", signWebhookBody("testing", batchBody))
+	wh.ServeHTTP(w, r)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("Expected %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+	if strings.Contains(w.Body.String(), "boom") {
+		t.Fatalf("Panic leaked in response: %q", w.Body.String())
+	}
+
+	outcomes := []string{OutcomeOK, OutcomeError, OutcomePanic, OutcomeOK}
+	if len(observed) != len(outcomes) {
+		t.Fatalf("Expected %d observations, got %d", len(outcomes), len(observed))
+	}
+	for i, o := range observed {
+		if o.Outcome != outcomes[i] {
+			t.Fatalf("%s: Expected %q, got %q", o.Event.Id, outcomes[i], o.Outcome)
+		}
+	}
+
+	var panicLogged, lineLogged bool
+	for _, line := range logger.lines {
+		if strings.HasPrefix(line, "panic handling event EV3: boom") && strings.Contains(line, "goroutine") {
+			panicLogged = true
+		}
+		if strings.HasPrefix(line, "event_id=EV3 resource_type= action= outcome=panic") && strings.Contains(line, "webhook_id=WB1") {
+			lineLogged = true
+		}
+	}
+	if !panicLogged || !lineLogged {
+		t.Fatalf("Expected panic and event to be logged, got %q", logger.lines)
+	}
+}
+
+func TestChainOrdersMiddlewares(t *testing.T) {
+	var order []string
+	mw := func(name string) EventMiddleware {
+		return func(next ContextEventHandler) ContextEventHandler {
+			return ContextEventHandlerFunc(func(ctx context.Context, e Event) error {
+				order = append(order, name)
+				return next.HandleEventContext(ctx, e)
+			})
+		}
+	}
+
+	h := Chain(EventHandlerFunc(func(e Event) error {
+		order = append(order, "handler")
+		return nil
+	}), mw("first"), mw("second"))
+
+	if err := h.HandleEvent(Event{}); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(order, ",") != "first,second,handler" {
+		t.Fatalf("Expected first,second,handler, got %v", order)
+	}
+}
@@ -0,0 +1,312 @@
+package gocardless
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// QueueItem is a verified webhook body waiting to be processed.
+type QueueItem struct {
+	// ID identifies the item within its queue.
+	ID string
+	// Body is the raw webhook body.
+	Body []byte
+	// ReceivedAt is the time the item was enqueued.
+	ReceivedAt time.Time
+}
+
+// Queue stores webhook bodies until a Worker processes them.
+type Queue interface {
+	// Enqueue durably stores the body.
+	Enqueue(ctx context.Context, body []byte) error
+	// Dequeue blocks until an item is available or ctx is done. The item
+	// stays in the queue, hidden from other callers, until it is acked or
+	// nacked.
+	Dequeue(ctx context.Context) (*QueueItem, error)
+	// Ack removes the item from the queue once it has been processed.
+	Ack(ctx context.Context, item *QueueItem) error
+	// Nack makes the item available again.
+	Nack(ctx context.Context, item *QueueItem) error
+}
+
+// WithQueue makes the WebhookHandler store verified webhook bodies in the
+// queue and acknowledge them straight away, rather than handling their
+// events. A Worker is then responsible for processing the queue.
+func WithQueue(q Queue) WebhookOption {
+	return func(h *WebhookHandler) error {
+		h.queue = q
+		return nil
+	}
+}
+
+func newQueueItemID() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate random id: %w", err)
+	}
+	return fmt.Sprintf("%020d-%s", time.Now().UTC().UnixNano(), hex.EncodeToString(buf)), nil
+}
+
+// MemoryQueue is a Queue keeping items in memory. Items are lost when the
+// process exits. It is safe for concurrent use.
+type MemoryQueue struct {
+	mu       sync.Mutex
+	pending  []*QueueItem
+	inflight map[string]*QueueItem
+	notify   chan struct{}
+}
+
+// NewMemoryQueue returns an empty MemoryQueue.
+func NewMemoryQueue() *MemoryQueue {
+	return &MemoryQueue{
+		inflight: make(map[string]*QueueItem),
+		notify:   make(chan struct{}, 1),
+	}
+}
+
+// Len returns the number of items in the queue, including the dequeued ones
+// which haven't been acked yet.
+func (q *MemoryQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.pending) + len(q.inflight)
+}
+
+// Enqueue adds the body to the queue.
+func (q *MemoryQueue) Enqueue(ctx context.Context, body []byte) error {
+	id, err := newQueueItemID()
+	if err != nil {
+		return err
+	}
+	item := &QueueItem{
+		ID:         id,
+		Body:       append([]byte(nil), body...),
+		ReceivedAt: time.Now(),
+	}
+	q.mu.Lock()
+	q.pending = append(q.pending, item)
+	q.mu.Unlock()
+	q.signal()
+	return nil
+}
+
+// Dequeue returns the oldest available item, blocking until there is one
+// or ctx is done.
+func (q *MemoryQueue) Dequeue(ctx context.Context) (*QueueItem, error) {
+	for {
+		q.mu.Lock()
+		if len(q.pending) > 0 {
+			item := q.pending[0]
+			q.pending = q.pending[1:]
+			q.inflight[item.ID] = item
+			q.mu.Unlock()
+			return item, nil
+		}
+		q.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-q.notify:
+		}
+	}
+}
+
+// Ack removes the item from the queue.
+func (q *MemoryQueue) Ack(ctx context.Context, item *QueueItem) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if _, ok := q.inflight[item.ID]; !ok {
+		return errors.New("unknown queue item")
+	}
+	delete(q.inflight, item.ID)
+	return nil
+}
+
+// Nack puts the item back at the front of the queue.
+func (q *MemoryQueue) Nack(ctx context.Context, item *QueueItem) error {
+	q.mu.Lock()
+	stored, ok := q.inflight[item.ID]
+	if !ok {
+		q.mu.Unlock()
+		return errors.New("unknown queue item")
+	}
+	delete(q.inflight, item.ID)
+	q.pending = append([]*QueueItem{stored}, q.pending...)
+	q.mu.Unlock()
+	q.signal()
+	return nil
+}
+
+func (q *MemoryQueue) signal() {
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+const fileQueueExt = ".webhook"
+
+// FileQueue is a Queue journaling items as files in a directory, so that
+// they survive restarts. Items dequeued but not acked when the process exits
+// are delivered again. It is safe for concurrent use within a process.
+type FileQueue struct {
+	dir          string
+	pollInterval time.Duration
+
+	mu       sync.Mutex
+	inflight map[string]bool
+	notify   chan struct{}
+}
+
+// NewFileQueue returns a FileQueue storing its items in dir, which is
+// created if needed. Items already in dir are part of the queue.
+func NewFileQueue(dir string) (*FileQueue, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &FileQueue{
+		dir:          dir,
+		pollInterval: time.Second,
+		inflight:     make(map[string]bool),
+		notify:       make(chan struct{}, 1),
+	}, nil
+}
+
+// Enqueue writes the body to a new file in the queue directory.
+func (q *FileQueue) Enqueue(ctx context.Context, body []byte) error {
+	id, err := newQueueItemID()
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(q.dir, "."+id+".tmp")
+
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(body)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp, filepath.Join(q.dir, id+fileQueueExt))
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+
+	q.signal()
+	return nil
+}
+
+// Dequeue returns the oldest available item, blocking until there is one
+// or ctx is done. The directory is polled for items written by other
+// processes.
+func (q *FileQueue) Dequeue(ctx context.Context) (*QueueItem, error) {
+	for {
+		item, err := q.next()
+		if err != nil || item != nil {
+			return item, err
+		}
+
+		timer := time.NewTimer(q.pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-q.notify:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+func (q *FileQueue) next() (*QueueItem, error) {
+	entries, err := os.ReadDir(q.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, fileQueueExt) {
+			continue
+		}
+		id := strings.TrimSuffix(name, fileQueueExt)
+		if q.inflight[id] {
+			continue
+		}
+
+		body, err := os.ReadFile(filepath.Join(q.dir, name))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		item := &QueueItem{
+			ID:   id,
+			Body: body,
+		}
+		if i := strings.IndexByte(id, '-'); i > 0 {
+			if ns, err := strconv.ParseInt(id[:i], 10, 64); err == nil {
+				item.ReceivedAt = time.Unix(0, ns)
+			}
+		}
+		q.inflight[id] = true
+		return item, nil
+	}
+	return nil, nil
+}
+
+// Ack deletes the file of the item.
+func (q *FileQueue) Ack(ctx context.Context, item *QueueItem) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.inflight[item.ID] {
+		return errors.New("unknown queue item")
+	}
+	if err := os.Remove(filepath.Join(q.dir, item.ID+fileQueueExt)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	delete(q.inflight, item.ID)
+	return nil
+}
+
+// Nack makes the item available again.
+func (q *FileQueue) Nack(ctx context.Context, item *QueueItem) error {
+	q.mu.Lock()
+	if !q.inflight[item.ID] {
+		q.mu.Unlock()
+		return errors.New("unknown queue item")
+	}
+	delete(q.inflight, item.ID)
+	q.mu.Unlock()
+	q.signal()
+	return nil
+}
+
+func (q *FileQueue) signal() {
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,128 @@
+package gocardless
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func testQueue(t *testing.T, q Queue) {
+	ctx := context.TODO()
+	for _, body := range []string{"first", "second"} {
+		if err := q.Enqueue(ctx, []byte(body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	item, err := q.Dequeue(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(item.Body) != "first" {
+		t.Fatalf("Expected %q, got %q", "first", item.Body)
+	}
+	if item.ReceivedAt.IsZero() {
+		t.Fatalf("Expected received time to be set")
+	}
+	if err := q.Nack(ctx, item); err != nil {
+		t.Fatal(err)
+	}
+
+	item, err = q.Dequeue(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(item.Body) != "first" {
+		t.Fatalf("Expected %q after nack, got %q", "first", item.Body)
+	}
+	if err := q.Ack(ctx, item); err != nil {
+		t.Fatal(err)
+	}
+
+	item, err = q.Dequeue(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(item.Body) != "second" {
+		t.Fatalf("Expected %q, got %q", "second", item.Body)
+	}
+	if err := q.Ack(ctx, item); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := q.Dequeue(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestMemoryQueue(t *testing.T) {
+	testQueue(t, NewMemoryQueue())
+}
+
+func TestFileQueue(t *testing.T) {
+	q, err := NewFileQueue(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	testQueue(t, q)
+}
+
+func TestFileQueueRedeliversUnackedItemsAfterRestart(t *testing.T) {
+	ctx := context.TODO()
+	dir := t.TempDir()
+
+	q, err := NewFileQueue(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q.Enqueue(ctx, []byte("body"))
+	if _, err := q.Dequeue(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	q, err = NewFileQueue(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	item, err := q.Dequeue(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(item.Body) != "body" {
+		t.Fatalf("Expected %q, got %q", "body", item.Body)
+	}
+}
+
+func TestWebhookEnqueuesVerifiedBodies(t *testing.T) {
+	q := NewMemoryQueue()
+	wh, err := NewWebhookHandler("testing", EventHandlerFunc(func(e Event) error {
+		t.Error("unexpected call")
+		return nil
+	}), WithQueue(q))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	send := func(signature string) int {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/webhook", bytes.NewReader(batchBody))
+		r.Header.Set("Webhook-Signature", signature)
+		wh.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	if code := send(signWebhookBody("wrong", batchBody)); code != 498 {
+		t.Fatalf("Expected %d, got %d", 498, code)
+	}
+	if code := send(signWebhookBody("testing", batchBody)); code != http.StatusNoContent {
+		t.Fatalf("Expected %d, got %d", http.StatusNoContent, code)
+	}
+	if q.Len() != 1 {
+		t.Fatalf("Expected 1 queued webhook, got %d", q.Len())
+	}
+}
@@ -0,0 +1,206 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DeadLetterSink receives the events a Worker failed to handle after
+// exhausting its attempts. Event is nil when the queued body itself couldn't
+// be decoded.
+type DeadLetterSink interface {
+	DeadLetter(ctx context.Context, item *QueueItem, e *Event, err error) error
+}
+
+// DeadLetterFunc can be used to convert a function into a DeadLetterSink
+type DeadLetterFunc func(ctx context.Context, item *QueueItem, e *Event, err error) error
+
+// DeadLetter will call the DeadLetterFunc function
+func (f DeadLetterFunc) DeadLetter(ctx context.Context, item *QueueItem, e *Event, err error) error {
+	return f(ctx, item, e, err)
+}
+
+// WorkerOption is used to configure a Worker
+type WorkerOption func(*Worker) error
+
+// WithMaxAttempts sets how many times a failing event is handled before
+// being sent to the dead letter sink. It defaults to 5.
+func WithMaxAttempts(n int) WorkerOption {
+	return func(w *Worker) error {
+		if n < 1 {
+			return errors.New("max attempts must be positive")
+		}
+		w.maxAttempts = n
+		return nil
+	}
+}
+
+// WithBackoff sets the delay before the first retry of a failing event,
+// doubled on each further retry up to max. It defaults to 1s and 1m.
+func WithBackoff(min, max time.Duration) WorkerOption {
+	return func(w *Worker) error {
+		if min <= 0 || max < min {
+			return errors.New("invalid backoff")
+		}
+		w.minBackoff = min
+		w.maxBackoff = max
+		return nil
+	}
+}
+
+// WithDeadLetter sets the sink receiving the events which kept failing.
+// Without one, the Worker fails with a *DeadLetterError instead, leaving the
+// item in the queue.
+func WithDeadLetter(sink DeadLetterSink) WorkerOption {
+	return func(w *Worker) error {
+		w.deadLetter = sink
+		return nil
+	}
+}
+
+// DeadLetterError is returned by a Worker without a dead letter sink when an
+// event keeps failing. Event is nil when the queued body itself couldn't be
+// decoded.
+type DeadLetterError struct {
+	Item  *QueueItem
+	Event *Event
+	Err   error
+}
+
+func (err *DeadLetterError) Error() string {
+	if err.Event == nil {
+		return fmt.Sprintf("queue item %s can't be decoded: %v", err.Item.ID, err.Err)
+	}
+	return fmt.Sprintf("event %s of queue item %s failed: %v", err.Event.Id, err.Item.ID, err.Err)
+}
+
+func (err *DeadLetterError) Unwrap() error {
+	return err.Err
+}
+
+// Worker processes the webhooks stored in a Queue by a WebhookHandler,
+// passing their events to an EventHandler. Events are handled at least once:
+// an item interrupted before being acked is processed again from its first
+// event.
+type Worker struct {
+	queue       Queue
+	handler     EventHandler
+	maxAttempts int
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	deadLetter  DeadLetterSink
+}
+
+// NewWorker returns a Worker draining q into h.
+func NewWorker(q Queue, h EventHandler, opts ...WorkerOption) (*Worker, error) {
+	if q == nil {
+		return nil, errors.New("missing queue")
+	}
+	if h == nil {
+		return nil, errors.New("missing event handler")
+	}
+	w := &Worker{
+		queue:       q,
+		handler:     h,
+		maxAttempts: 5,
+		minBackoff:  time.Second,
+		maxBackoff:  time.Minute,
+	}
+	for _, opt := range opts {
+		if err := opt(w); err != nil {
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+// Run processes queued webhooks until ctx is done or an item can't be
+// dequeued, acked, nacked or dead lettered.
+func (w *Worker) Run(ctx context.Context) error {
+	for {
+		if err := w.ProcessNext(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+// ProcessNext waits for the next queued webhook and processes it.
+func (w *Worker) ProcessNext(ctx context.Context) error {
+	item, err := w.queue.Dequeue(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = w.process(ctx, item)
+	if err != nil {
+		// Use a fresh context, as ctx may be the reason processing stopped.
+		if nerr := w.queue.Nack(context.Background(), item); nerr != nil {
+			return fmt.Errorf("%w, and nacking the item failed: %v", err, nerr)
+		}
+		return err
+	}
+	return w.queue.Ack(ctx, item)
+}
+
+func (w *Worker) process(ctx context.Context, item *QueueItem) error {
+	var batch webhookBody
+	if err := json.Unmarshal(item.Body, &batch); err != nil {
+		return w.sendToDeadLetter(ctx, item, nil, err)
+	}
+
+	ctx = ContextWithEnrichCache(ctx, NewEnrichCache())
+	info := WebhookInfo{
+		WebhookID:  batch.Meta.WebhookID,
+		ReceivedAt: item.ReceivedAt,
+		BatchSize:  len(batch.Events),
+	}
+	for i := range batch.Events {
+		info.Index = i
+		err := w.handle(ContextWithWebhookInfo(ctx, info), batch.Events[i])
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			if err := w.sendToDeadLetter(ctx, item, &batch.Events[i], err); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// handle calls the event handler until it succeeds, backing off between
+// attempts, and returns the last error.
+func (w *Worker) handle(ctx context.Context, e Event) error {
+	backoff := w.minBackoff
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = handleEvent(ctx, w.handler, e)
+		if err == nil || attempt >= w.maxAttempts {
+			return err
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		backoff *= 2
+		if backoff > w.maxBackoff {
+			backoff = w.maxBackoff
+		}
+	}
+}
+
+func (w *Worker) sendToDeadLetter(ctx context.Context, item *QueueItem, e *Event, err error) error {
+	if w.deadLetter == nil {
+		return &DeadLetterError{Item: item, Event: e, Err: err}
+	}
+	return w.deadLetter.DeadLetter(ctx, item, e, err)
+}
@@ -0,0 +1,134 @@
+package gocardless
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWorkerRetriesAndDeadLettersEvents(t *testing.T) {
+	ctx := context.TODO()
+	q := NewMemoryQueue()
+	q.Enqueue(ctx, batchBody)
+	q.Enqueue(ctx, []byte("not json"))
+
+	attempts := map[string]int{}
+	var dead []string
+	w, err := NewWorker(q, ContextEventHandlerFunc(func(ctx context.Context, e Event) error {
+		attempts[e.Id]++
+		info, _ := WebhookInfoFromContext(ctx)
+		if info.WebhookID != "WB1" {
+			t.Fatalf("Expected %q, got %q", "WB1", info.WebhookID)
+		}
+		switch {
+		case e.Id == "EV2":
+			return errors.New("permanent")
+		case e.Id == "EV3" && attempts[e.Id] < 2:
+			return errors.New("transient")
+		}
+		return nil
+	}),
+		WithMaxAttempts(3),
+		WithBackoff(time.Millisecond, 2*time.Millisecond),
+		WithDeadLetter(DeadLetterFunc(func(ctx context.Context, item *QueueItem, e *Event, err error) error {
+			if e == nil {
+				dead = append(dead, "body")
+			} else {
+				dead = append(dead, e.Id)
+			}
+			return nil
+		})),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := w.ProcessNext(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := map[string]int{"EV1": 1, "EV2": 3, "EV3": 2, "EV4": 1}
+	for id, n := range expected {
+		if attempts[id] != n {
+			t.Fatalf("%s: Expected %d attempts, got %d", id, n, attempts[id])
+		}
+	}
+	if len(dead) != 2 || dead[0] != "EV2" || dead[1] != "body" {
+		t.Fatalf("Expected [EV2 body] dead lettered, got %v", dead)
+	}
+	if q.Len() != 0 {
+		t.Fatalf("Expected empty queue, got %d items", q.Len())
+	}
+}
+
+func TestWorkerNacksItemWhenDeadLetterFails(t *testing.T) {
+	ctx := context.TODO()
+	q := NewMemoryQueue()
+	q.Enqueue(ctx, batchBody)
+
+	w, err := NewWorker(q, EventHandlerFunc(failSecondEvent),
+		WithMaxAttempts(1),
+		WithDeadLetter(DeadLetterFunc(func(ctx context.Context, item *QueueItem, e *Event, err error) error {
+			return errors.New("sink unavailable")
+		})),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := w.ProcessNext(ctx); err == nil {
+		t.Fatal("Expected error")
+	}
+	if q.Len() != 1 {
+		t.Fatalf("Expected item to stay queued, got %d items", q.Len())
+	}
+}
+
+func TestWorkerWithoutDeadLetterKeepsItem(t *testing.T) {
+	ctx := context.TODO()
+	q := NewMemoryQueue()
+	q.Enqueue(ctx, batchBody)
+
+	w, err := NewWorker(q, EventHandlerFunc(failSecondEvent), WithMaxAttempts(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = w.ProcessNext(ctx)
+	var dlErr *DeadLetterError
+	if !errors.As(err, &dlErr) || dlErr.Event == nil || dlErr.Event.Id != "EV2" {
+		t.Fatalf("Expected *DeadLetterError for EV2, got %v", err)
+	}
+	if q.Len() != 1 {
+		t.Fatalf("Expected item to stay queued, got %d items", q.Len())
+	}
+}
+
+type failingNackQueue struct {
+	*MemoryQueue
+}
+
+func (q failingNackQueue) Nack(ctx context.Context, item *QueueItem) error {
+	return errors.New("disk full")
+}
+
+func TestWorkerReturnsNackErrors(t *testing.T) {
+	ctx := context.TODO()
+	q := failingNackQueue{NewMemoryQueue()}
+	q.Enqueue(ctx, batchBody)
+
+	w, err := NewWorker(q, EventHandlerFunc(failSecondEvent), WithMaxAttempts(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = w.ProcessNext(ctx)
+	var dlErr *DeadLetterError
+	if !errors.As(err, &dlErr) || !strings.Contains(err.Error(), "disk full") {
+		t.Fatalf("Expected nack error, got %v", err)
+	}
+}
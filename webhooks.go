@@ -1,13 +1,17 @@
 package gocardless
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"io"
+	"mime"
 	"net/http"
+	"sync"
+	"time"
 )
 
 // EventHandler is the interface that must be implemented to handle events from a webhook.
@@ -23,54 +27,361 @@ func (h EventHandlerFunc) HandleEvent(e Event) error {
 	return h(e)
 }
 
+// ContextEventHandler is implemented by event handlers needing the context of
+// the incoming webhook request. WebhookHandler prefers it over EventHandler
+// when the handler implements both.
+type ContextEventHandler interface {
+	HandleEventContext(context.Context, Event) error
+}
+
+// ContextEventHandlerFunc can be used to convert a function into a
+// ContextEventHandler. It also implements EventHandler, using a background
+// context.
+type ContextEventHandlerFunc func(context.Context, Event) error
+
+// HandleEventContext will call the ContextEventHandlerFunc function
+func (h ContextEventHandlerFunc) HandleEventContext(ctx context.Context, e Event) error {
+	return h(ctx, e)
+}
+
+// HandleEvent will call the ContextEventHandlerFunc function with a background context
+func (h ContextEventHandlerFunc) HandleEvent(e Event) error {
+	return h(context.Background(), e)
+}
+
+// WebhookInfo describes the webhook delivery an event was received with.
+type WebhookInfo struct {
+	// WebhookID is the ID of the webhook, as sent in the body meta.
+	WebhookID string
+	// ReceivedAt is the time the webhook request was received.
+	ReceivedAt time.Time
+	// Index is the position of the event within the webhook batch.
+	Index int
+	// BatchSize is the number of events in the webhook batch.
+	BatchSize int
+	// SecretIndex is the index of the secret the webhook was signed with.
+	SecretIndex int
+}
+
+type webhookInfoKey struct{}
+
+// ContextWithWebhookInfo returns a copy of ctx carrying the webhook info.
+func ContextWithWebhookInfo(ctx context.Context, info WebhookInfo) context.Context {
+	return context.WithValue(ctx, webhookInfoKey{}, info)
+}
+
+// WebhookInfoFromContext returns the webhook info stored in ctx by the
+// WebhookHandler, if any.
+func WebhookInfoFromContext(ctx context.Context) (WebhookInfo, bool) {
+	info, ok := ctx.Value(webhookInfoKey{}).(WebhookInfo)
+	return info, ok
+}
+
+// DefaultWebhookMaxBodySize is the default limit on the size of webhook
+// request bodies accepted by a WebhookHandler.
+const DefaultWebhookMaxBodySize = 1 << 20
+
+// ErrInvalidSignature is returned when a webhook signature doesn't match its body.
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// VerifyWebhookSignature checks that the hex encoded signature sent in the
+// Webhook-Signature header is the HMAC-SHA256 of body under one of secrets.
+// The comparison is made in constant time.
+func VerifyWebhookSignature(body []byte, signature string, secrets ...string) error {
+	_, err := MatchWebhookSignature(body, signature, secrets...)
+	return err
+}
+
+// MatchWebhookSignature is like VerifyWebhookSignature, but also returns the
+// index in secrets of the secret the body was signed with. Every secret is
+// checked, so the time taken doesn't depend on which one matches.
+func MatchWebhookSignature(body []byte, signature string, secrets ...string) (int, error) {
+	sig, err := hex.DecodeString(signature)
+	if err != nil || len(sig) == 0 {
+		return -1, ErrInvalidSignature
+	}
+
+	matched := -1
+	for i, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(body)
+		if hmac.Equal(sig, mac.Sum(nil)) && matched < 0 {
+			matched = i
+		}
+	}
+	if matched < 0 {
+		return -1, ErrInvalidSignature
+	}
+	return matched, nil
+}
+
+// WebhookSecrets holds a set of webhook secrets which can be replaced while
+// the WebhookHandler using them is serving requests. It is safe for
+// concurrent use.
+type WebhookSecrets struct {
+	mu      sync.RWMutex
+	secrets []string
+}
+
+// NewWebhookSecrets returns a WebhookSecrets holding the given secrets.
+func NewWebhookSecrets(secrets ...string) *WebhookSecrets {
+	s := &WebhookSecrets{}
+	s.Set(secrets...)
+	return s
+}
+
+// Set replaces the secrets.
+func (s *WebhookSecrets) Set(secrets ...string) {
+	secrets = append([]string(nil), secrets...)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.secrets = secrets
+}
+
+// Secrets returns the current secrets. Its signature allows it to be used
+// with WithSecretProvider.
+func (s *WebhookSecrets) Secrets(ctx context.Context) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.secrets, nil
+}
+
+type webhookBody struct {
+	Events []Event `json:"events"`
+	Meta   struct {
+		WebhookID string `json:"webhook_id"`
+	} `json:"meta"`
+}
+
+// WebhookOption is used to configure a WebhookHandler
+type WebhookOption func(*WebhookHandler) error
+
+// WithMaxBodySize sets the maximum size in bytes of the webhook request
+// bodies. Larger requests are rejected with 413 Request Entity Too Large.
+func WithMaxBodySize(n int64) WebhookOption {
+	return func(h *WebhookHandler) error {
+		if n <= 0 {
+			return errors.New("max body size must be positive")
+		}
+		h.maxBodySize = n
+		return nil
+	}
+}
+
+// WithSecrets adds secrets accepted alongside the one given to
+// NewWebhookHandler, e.g. while rotating the endpoint secret.
+func WithSecrets(secrets ...string) WebhookOption {
+	return func(h *WebhookHandler) error {
+		for _, secret := range secrets {
+			if secret == "" {
+				return errors.New("missing secret")
+			}
+		}
+		h.secrets = append(h.secrets, secrets...)
+		return nil
+	}
+}
+
+// WithSecretProvider sets a function called on every request to fetch the
+// secrets accepted in addition to the static ones, so that secrets can be
+// rotated without restarting the server. The secrets it returns are indexed
+// after the static ones.
+func WithSecretProvider(provider func(context.Context) ([]string, error)) WebhookOption {
+	return func(h *WebhookHandler) error {
+		h.secretProvider = provider
+		return nil
+	}
+}
+
+// WithSecretMatchCallback sets a function called with the index of the
+// secret each valid request was signed with. Indexes start with the secret
+// given to NewWebhookHandler, followed by those added with WithSecrets and
+// then those returned by the secret provider.
+func WithSecretMatchCallback(fn func(r *http.Request, index int)) WebhookOption {
+	return func(h *WebhookHandler) error {
+		h.onSecretMatch = fn
+		return nil
+	}
+}
+
+// WithEventStore sets the store used to skip events which have already been
+// processed. Events are recorded in the store once their handler succeeds,
+// and concurrent deliveries of the same event are handled one at a time.
+func WithEventStore(store EventStore) WebhookOption {
+	return func(h *WebhookHandler) error {
+		h.store = store
+		return nil
+	}
+}
+
 // WebhookHandler allows you to process incoming events from webhooks.
 type WebhookHandler struct {
 	EventHandler
-	secret string
+	secrets        []string
+	secretProvider func(context.Context) ([]string, error)
+	onSecretMatch  func(*http.Request, int)
+	maxBodySize    int64
+	store          EventStore
+	eventLocks     keyedMutex
+	batchPolicy    BatchPolicy
+	onBatchReport  func(context.Context, *BatchReport) error
+	concurrency    int
+	queue          Queue
+	middlewares    []EventMiddleware
 }
 
 // NewWebhookHandler instantiates a WebhookHandler which can be mounted as a net/http Handler.
-func NewWebhookHandler(secret string, h EventHandler) (*WebhookHandler, error) {
-	if secret == "" {
+//
+// The secret may only be empty when other secrets are configured through
+// WithSecrets or WithSecretProvider.
+func NewWebhookHandler(secret string, h EventHandler, opts ...WebhookOption) (*WebhookHandler, error) {
+	wh := &WebhookHandler{
+		EventHandler: h,
+		maxBodySize:  DefaultWebhookMaxBodySize,
+	}
+	if secret != "" {
+		wh.secrets = []string{secret}
+	}
+	for _, opt := range opts {
+		if err := opt(wh); err != nil {
+			return nil, err
+		}
+	}
+	if len(wh.secrets) == 0 && wh.secretProvider == nil {
 		return nil, errors.New("missing secret")
 	}
-	return &WebhookHandler{
-		EventHandler: h,
-		secret:       secret,
-	}, nil
+	if wh.batchPolicy == AcceptFailures && wh.onBatchReport == nil {
+		return nil, errors.New("accepting failures requires a batch report callback")
+	}
+	if len(wh.middlewares) > 0 && wh.EventHandler != nil {
+		wh.EventHandler = Chain(wh.EventHandler, wh.middlewares...)
+	}
+	return wh, nil
+}
+
+func (h *WebhookHandler) currentSecrets(ctx context.Context) ([]string, error) {
+	if h.secretProvider == nil {
+		return h.secrets, nil
+	}
+	dynamic, err := h.secretProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+	secrets := make([]string, 0, len(h.secrets)+len(dynamic))
+	secrets = append(secrets, h.secrets...)
+	return append(secrets, dynamic...), nil
 }
 
 // ServeHTTP processes incoming webhooks and dispatches events to the corresponsing handlers.
+//
+// Only POST requests are accepted. Requests declaring a Content-Type other than
+// application/json are rejected. The body is read up to the configured size
+// limit and its signature is verified before it gets decoded.
 func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	sig, err := hex.DecodeString(r.Header.Get("Webhook-Signature"))
-	if len(sig) == 0 {
-		http.Error(w, "invalid signature", 498)
+	receivedAt := time.Now()
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
 		return
 	}
-	hash := hmac.New(sha256.New, []byte(h.secret))
-	body := io.TeeReader(r.Body, hash)
-
-	var events struct {
-		Events []Event `json:"events"`
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		mt, _, err := mime.ParseMediaType(ct)
+		if err != nil || mt != "application/json" {
+			http.Error(w, "unsupported content type", http.StatusUnsupportedMediaType)
+			return
+		}
 	}
-	err = json.NewDecoder(body).Decode(&events)
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, h.maxBodySize+1))
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	if int64(len(body)) > h.maxBodySize {
+		http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
 		return
 	}
 
-	if !hmac.Equal(sig, hash.Sum(nil)) {
+	secrets, err := h.currentSecrets(r.Context())
+	if err != nil {
+		http.Error(w, "secrets unavailable", http.StatusInternalServerError)
+		return
+	}
+	secretIndex, err := MatchWebhookSignature(body, r.Header.Get("Webhook-Signature"), secrets...)
+	if err != nil {
 		http.Error(w, "invalid signature", 498)
 		return
 	}
+	if h.onSecretMatch != nil {
+		h.onSecretMatch(r, secretIndex)
+	}
+
+	var events webhookBody
+	err = json.Unmarshal(body, &events)
+	if err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
 
-	for _, event := range events.Events {
-		err := h.HandleEvent(event)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+	if h.queue != nil {
+		if err := h.queue.Enqueue(r.Context(), body); err != nil {
+			http.Error(w, "failed to queue webhook", http.StatusInternalServerError)
 			return
 		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	report := h.processBatch(r.Context(), events, WebhookInfo{
+		WebhookID:   events.Meta.WebhookID,
+		ReceivedAt:  receivedAt,
+		BatchSize:   len(events.Events),
+		SecretIndex: secretIndex,
+	})
+	if err := h.reportBatch(r.Context(), report); err != nil {
+		// Handler errors and panics aren't for the sender to see.
+		http.Error(w, "failed to handle webhook", http.StatusInternalServerError)
+		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// processEvent handles the event unless the event store reports it as
+// already processed, in which case it returns true.
+func (h *WebhookHandler) processEvent(ctx context.Context, e Event) (bool, error) {
+	if h.store == nil || e.Id == "" {
+		return false, h.handleEvent(ctx, e)
+	}
+
+	unlock := h.eventLocks.lock(e.Id)
+	defer unlock()
+
+	processed, err := h.store.Processed(ctx, e.Id)
+	if err != nil {
+		return false, err
+	}
+	if processed {
+		return true, nil
+	}
+	if err := h.handleEvent(ctx, e); err != nil {
+		return false, err
+	}
+	return false, h.store.MarkProcessed(ctx, e.Id)
+}
+
+func (h *WebhookHandler) handleEvent(ctx context.Context, e Event) error {
+	return handleEvent(ctx, h.EventHandler, e)
+}
+
+// handleEvent passes the event to h, along with ctx if h implements
+// ContextEventHandler.
+func handleEvent(ctx context.Context, h EventHandler, e Event) error {
+	if ch, ok := h.(ContextEventHandler); ok {
+		return ch.HandleEventContext(ctx, e)
+	}
+	return h.HandleEvent(e)
+}
@@ -1,10 +1,16 @@
 package gocardless
 
 import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -107,3 +113,169 @@ func TestWebhookWhenHandlerFails(t *testing.T) {
 		t.Fatalf("Expected 1 call, got %d", called)
 	}
 }
+
+func signWebhookBody(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestWebhookPrefersContextEventHandler(t *testing.T) {
+	type ctxKey struct{}
+	body := []byte(`{"events":[{"id":"EV1","resource_type":"payments","action":"confirmed"},{"id":"EV2","resource_type":"payments","action":"paid_out"}],"meta":{"webhook_id":"WB123"}}`)
+
+	var infos []WebhookInfo
+	wh, err := NewWebhookHandler("testing", ContextEventHandlerFunc(func(ctx context.Context, e Event) error {
+		if ctx.Value(ctxKey{}) != "request" {
+			t.Fatalf("Expected request context to be propagated")
+		}
+		info, ok := WebhookInfoFromContext(ctx)
+		if !ok {
+			t.Fatalf("Expected webhook info in context")
+		}
+		infos = append(infos, info)
+		return nil
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "/webhook", bytes.NewReader(body))
+	r = r.WithContext(context.WithValue(r.Context(), ctxKey{}, "request"))
+	r.Header.Set("Webhook-Signature", signWebhookBody("testing", body))
+
+	wh.ServeHTTP(w, r)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("Expected %d, got %d", http.StatusNoContent, w.Code)
+	}
+
+	if len(infos) != 2 {
+		t.Fatalf("Expected 2 calls, got %d", len(infos))
+	}
+	for i, info := range infos {
+		if info.WebhookID != "WB123" {
+			t.Fatalf("Expected %q, got %q", "WB123", info.WebhookID)
+		}
+		if info.Index != i || info.BatchSize != 2 {
+			t.Fatalf("Expected index %d of 2, got %d of %d", i, info.Index, info.BatchSize)
+		}
+		if info.ReceivedAt.IsZero() {
+			t.Fatalf("Expected received time to be set")
+		}
+	}
+}
+
+func TestWebhookRejectsInvalidRequests(t *testing.T) {
+	body := []byte(`{"events":[{"id":"EV1"}]}`)
+	tests := []struct {
+		name        string
+		method      string
+		contentType string
+		body        []byte
+		signature   string
+		code        int
+	}{
+		{"wrong method", "GET", "", nil, signWebhookBody("testing", nil), http.StatusMethodNotAllowed},
+		{"wrong content type", "POST", "text/plain", body, signWebhookBody("testing", body), http.StatusUnsupportedMediaType},
+		{"too large", "POST", "application/json", bytes.Repeat([]byte(" "), 65), "", http.StatusRequestEntityTooLarge},
+		{"signed garbage", "POST", "application/json", []byte("{"), signWebhookBody("testing", []byte("{")), http.StatusBadRequest},
+		{"unsigned garbage", "POST", "application/json; charset=utf-8", []byte("{"), "", 498},
+	}
+
+	for _, tt := range tests {
+		wh, err := NewWebhookHandler("testing", EventHandlerFunc(func(e Event) error {
+			t.Errorf("%s: unexpected call", tt.name)
+			return nil
+		}), WithMaxBodySize(64))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(tt.method, "/webhook", bytes.NewReader(tt.body))
+		if tt.contentType != "" {
+			r.Header.Set("Content-Type", tt.contentType)
+		}
+		r.Header.Set("Webhook-Signature", tt.signature)
+
+		wh.ServeHTTP(w, r)
+		if w.Code != tt.code {
+			t.Fatalf("%s: Expected %d, got %d", tt.name, tt.code, w.Code)
+		}
+		if strings.Contains(w.Body.String(), "unexpected") {
+			t.Fatalf("%s: decoder error leaked in response: %q", tt.name, w.Body.String())
+		}
+	}
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	body := []byte(`{"events":[]}`)
+	sig := signWebhookBody("new", body)
+
+	if err := VerifyWebhookSignature(body, sig, "new"); err != nil {
+		t.Fatalf("Expected nil, got %v", err)
+	}
+	if err := VerifyWebhookSignature(body, sig, "old", "new"); err != nil {
+		t.Fatalf("Expected nil, got %v", err)
+	}
+	if err := VerifyWebhookSignature(body, sig, "old"); err != ErrInvalidSignature {
+		t.Fatalf("Expected %v, got %v", ErrInvalidSignature, err)
+	}
+	if err := VerifyWebhookSignature(body, "not-hex", "new"); err != ErrInvalidSignature {
+		t.Fatalf("Expected %v, got %v", ErrInvalidSignature, err)
+	}
+}
+
+func TestWebhookAcceptsRotatedSecrets(t *testing.T) {
+	body := []byte(`{"events":[{"id":"EV1"}]}`)
+	secrets := NewWebhookSecrets("rotated")
+
+	var matched []int
+	wh, err := NewWebhookHandler("primary", EventHandlerFunc(func(e Event) error {
+		return nil
+	}), WithSecrets("old"), WithSecretProvider(secrets.Secrets), WithSecretMatchCallback(func(r *http.Request, index int) {
+		matched = append(matched, index)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	send := func(secret string) int {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/webhook", bytes.NewReader(body))
+		r.Header.Set("Webhook-Signature", signWebhookBody(secret, body))
+		wh.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	for _, secret := range []string{"primary", "old", "rotated"} {
+		if code := send(secret); code != http.StatusNoContent {
+			t.Fatalf("%s: Expected %d, got %d", secret, http.StatusNoContent, code)
+		}
+	}
+	expected := []int{0, 1, 2}
+	for i := range expected {
+		if matched[i] != expected[i] {
+			t.Fatalf("Expected %v, got %v", expected, matched)
+		}
+	}
+
+	secrets.Set("reloaded")
+	if code := send("rotated"); code != 498 {
+		t.Fatalf("Expected %d, got %d", 498, code)
+	}
+	if code := send("reloaded"); code != http.StatusNoContent {
+		t.Fatalf("Expected %d, got %d", http.StatusNoContent, code)
+	}
+}
+
+func TestNewWebhookHandlerRequiresSecret(t *testing.T) {
+	h := EventHandlerFunc(func(e Event) error { return nil })
+	if _, err := NewWebhookHandler("", h); err == nil {
+		t.Fatal("Expected error without secret")
+	}
+	if _, err := NewWebhookHandler("", h, WithSecrets("a", "b")); err != nil {
+		t.Fatalf("Expected nil, got %v", err)
+	}
+}
@@ -0,0 +1,160 @@
+// Package webhooktest builds and sends signed GoCardless webhooks, for
+// testing webhook endpoints end-to-end.
+package webhooktest
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	gocardless "github.com/gocardless/gocardless-pro-go/v2"
+)
+
+// Webhook is the body of a webhook as sent by GoCardless.
+type Webhook struct {
+	Events []gocardless.Event `json:"events"`
+	Meta   Meta               `json:"meta"`
+}
+
+// Meta holds the webhook metadata.
+type Meta struct {
+	WebhookID string `json:"webhook_id"`
+}
+
+// NewWebhook returns a webhook holding the events, with a random ID.
+func NewWebhook(events ...gocardless.Event) (*Webhook, error) {
+	id, err := randomID("WB")
+	if err != nil {
+		return nil, err
+	}
+	return &Webhook{
+		Events: events,
+		Meta: Meta{
+			WebhookID: id,
+		},
+	}, nil
+}
+
+// NewEvent returns an event for the given resource type and action, with a
+// random ID, the current time and its links pointing to resourceID.
+func NewEvent(resourceType gocardless.ResourceType, action gocardless.EventAction, resourceID string) (gocardless.Event, error) {
+	id, err := randomID("EV")
+	if err != nil {
+		return gocardless.Event{}, err
+	}
+	return gocardless.Event{
+		Id:           id,
+		CreatedAt:    gocardless.NewTimestamp(time.Now().UTC().Truncate(time.Millisecond)),
+		ResourceType: resourceType,
+		Action:       action,
+		Links:        links(resourceType, resourceID),
+		Details: &gocardless.EventDetails{
+			Origin:      "gocardless",
+			Cause:       string(action),
+			Description: "Simulated " + string(resourceType) + " " + string(action) + " event.",
+		},
+		Metadata: gocardless.Metadata{},
+	}, nil
+}
+
+func links(resourceType gocardless.ResourceType, id string) *gocardless.EventLinks {
+	l := &gocardless.EventLinks{}
+	switch resourceType {
+	case gocardless.ResourceBillingRequests:
+		l.BillingRequest = id
+	case gocardless.ResourceCreditors:
+		l.Creditor = id
+	case gocardless.ResourceCustomers:
+		l.Customer = id
+	case gocardless.ResourceInstalmentSchedules:
+		l.InstalmentSchedule = id
+	case gocardless.ResourceMandates:
+		l.Mandate = id
+	case gocardless.ResourceOrganisations:
+		l.Organisation = id
+	case gocardless.ResourcePayerAuthorisations:
+		l.PayerAuthorisation = id
+	case gocardless.ResourcePayments:
+		l.Payment = id
+	case gocardless.ResourcePayouts:
+		l.Payout = id
+	case gocardless.ResourceRefunds:
+		l.Refund = id
+	case gocardless.ResourceSubscriptions:
+		l.Subscription = id
+	}
+	return l
+}
+
+const idAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+func randomID(prefix string) (string, error) {
+	buf := make([]byte, 12)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate random id: %w", err)
+	}
+	for i, b := range buf {
+		buf[i] = idAlphabet[int(b)%len(idAlphabet)]
+	}
+	return prefix + string(buf), nil
+}
+
+// Body returns the JSON encoding of the webhook.
+func (w *Webhook) Body() ([]byte, error) {
+	return json.Marshal(w)
+}
+
+// Sign returns the Webhook-Signature header value of body under secret.
+func Sign(body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewRequest returns a POST request to url delivering body, signed with
+// secret the way GoCardless does.
+func NewRequest(ctx context.Context, url, secret string, body []byte) (*http.Request, error) {
+	if secret == "" {
+		return nil, errors.New("missing secret")
+	}
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Origin", "https://api.gocardless.com")
+	req.Header.Set("User-Agent", "gocardless-webhook-service/1.1")
+	req.Header.Set("Webhook-Signature", Sign(body, secret))
+	return req, nil
+}
+
+// Send delivers the webhook to url using client, or http.DefaultClient if
+// client is nil. The caller must close the response body.
+func Send(ctx context.Context, client *http.Client, url, secret string, w *Webhook) (*http.Response, error) {
+	body, err := w.Body()
+	if err != nil {
+		return nil, err
+	}
+	return SendBody(ctx, client, url, secret, body)
+}
+
+// SendBody delivers a raw webhook body to url, signed with secret.
+func SendBody(ctx context.Context, client *http.Client, url, secret string, body []byte) (*http.Response, error) {
+	req, err := NewRequest(ctx, url, secret, body)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return client.Do(req)
+}
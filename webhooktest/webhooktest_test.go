@@ -0,0 +1,97 @@
+package webhooktest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	gocardless "github.com/gocardless/gocardless-pro-go/v2"
+)
+
+func TestSignMatchesFixture(t *testing.T) {
+	body, err := os.ReadFile("../testdata/webhook_request.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "243f3efa57743c24eec7c5e10edc475b547830d256d5b583745afe319dd90936"
+	if got := Sign(body, "testing"); got != expected {
+		t.Fatalf("Expected %q, got %q", expected, got)
+	}
+}
+
+func TestSendDeliversToWebhookHandler(t *testing.T) {
+	var got []gocardless.Event
+	wh, err := gocardless.NewWebhookHandler("secret", gocardless.EventHandlerFunc(func(e gocardless.Event) error {
+		got = append(got, e)
+		return nil
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(wh)
+	defer server.Close()
+
+	payment, err := NewEvent(gocardless.ResourcePayments, gocardless.ActionConfirmed, "PM123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mandate, err := NewEvent(gocardless.ResourceMandates, gocardless.ActionCancelled, "MD123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := NewWebhook(payment, mandate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := Send(context.TODO(), nil, server.URL, "secret", w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Fatalf("Expected %d, got %d", http.StatusNoContent, res.StatusCode)
+	}
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 events, got %d", len(got))
+	}
+	if got[0].ResourceID() != "PM123" || got[1].ResourceID() != "MD123" {
+		t.Fatalf("Expected links to PM123 and MD123, got %q and %q", got[0].ResourceID(), got[1].ResourceID())
+	}
+	if got[0].Id == got[1].Id {
+		t.Fatalf("Expected distinct event IDs, got %q twice", got[0].Id)
+	}
+}
+
+func TestSendWithWrongSecretIsRejected(t *testing.T) {
+	wh, err := gocardless.NewWebhookHandler("secret", gocardless.EventHandlerFunc(func(e gocardless.Event) error {
+		t.Error("unexpected call")
+		return nil
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(wh)
+	defer server.Close()
+
+	e, err := NewEvent(gocardless.ResourcePayouts, gocardless.ActionPaid, "PO123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := NewWebhook(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := Send(context.TODO(), nil, server.URL, "wrong", w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != 498 {
+		t.Fatalf("Expected %d, got %d", 498, res.StatusCode)
+	}
+}